@@ -175,6 +175,17 @@ func FileHash(file string) ([HashSize]byte, error) {
 	return out, nil
 }
 
+// ForgetFileHash removes the cached hashes of the files,
+// they are computed again by FileHash.
+// It must be called when files are modified during the life of the process.
+func ForgetFileHash(files ...string) {
+	hashFileCache.Lock()
+	for _, file := range files {
+		delete(hashFileCache.m, file)
+	}
+	hashFileCache.Unlock()
+}
+
 // SetFileHash sets the hash returned by FileHash for file.
 func SetFileHash(file string, sum [HashSize]byte) {
 	hashFileCache.Lock()
@@ -48,6 +48,14 @@ func NewCache(sw *timeutils.Stopwatch, log logutils.Log) (*Cache, error) {
 	}, nil
 }
 
+// ForgetPackageHashes forgets the computed hashes of the packages and of their dependencies,
+// for the long-running processes loading the packages again for each analysis.
+func (c *Cache) ForgetPackageHashes(pkgs []*packages.Package) {
+	packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+		c.pkgHashes.Delete(pkg)
+	})
+}
+
 func (c *Cache) Trim() {
 	c.sw.TrackStage("trim", func() {
 		c.lowLevelCache.Trim()
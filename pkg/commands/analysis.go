@@ -0,0 +1,204 @@
+package commands
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"slices"
+	"sort"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/snowmerak/golangci-lint/internal/cache"
+	"github.com/snowmerak/golangci-lint/internal/pkgcache"
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/fsutils"
+	"github.com/snowmerak/golangci-lint/pkg/goanalysis/load"
+	"github.com/snowmerak/golangci-lint/pkg/goutil"
+	"github.com/snowmerak/golangci-lint/pkg/lint"
+	"github.com/snowmerak/golangci-lint/pkg/lint/lintersdb"
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+	"github.com/snowmerak/golangci-lint/pkg/report"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+	"github.com/snowmerak/golangci-lint/pkg/result/processors"
+)
+
+// analysisResult is the result of an analysis run by a long-running command (ex: daemon, lsp).
+type analysisResult struct {
+	issues     []result.Issue
+	reportData *report.Data
+
+	// The issues about the configuration (ex: the exclusions which matched no issue),
+	// when the output processing is deferred: they are included in the issues otherwise.
+	reported []result.Issue
+
+	// The analyzed packages.
+	packages []*packages.Package
+
+	// The exclusions of the configuration with the numbers of issues they matched.
+	exclusions []processors.Exclusion
+
+	// The nolint directives of the analyzed files, if requested.
+	directives []processors.NolintDirective
+
+	// Compute the fingerprints of the issues for the output even if the configuration doesn't use them:
+	// they compare the issues with the ones of a previous analysis.
+	fingerprints bool
+}
+
+// analysisOptions are the options of an analysis run by analyzePackages.
+type analysisOptions struct {
+	// The contents of the files that differ from the disk, by absolute path.
+	overlay map[string][]byte
+
+	// The directory where the packages are loaded (ex: the root directory of a module).
+	// The packages are loaded from the working directory if empty.
+	dir string
+
+	// Collect the nolint directives of the analyzed files.
+	nolintDirectives bool
+
+	// Don't process the issues for the output:
+	// the issues of several analyses are merged, then processed at once (see processMergedIssues).
+	deferOutput bool
+}
+
+// analyzePackages runs the enabled linters on the packages matching the arguments,
+// for the commands keeping the go env and the package cache between the analyses.
+func analyzePackages(ctx context.Context, logger logutils.Log, cfg *config.Config, goenv *goutil.Env,
+	pkgCache *pkgcache.Cache, args []string, opts analysisOptions,
+) (*analysisResult, error) {
+	reportData := &report.Data{}
+	log := report.NewLogWrapper(logger, reportData)
+
+	// The linters are created for each analysis: some of them keep their issues until the end of the process.
+	dbManager, err := lintersdb.NewManager(log.Child(logutils.DebugKeyLintersDB), cfg,
+		lintersdb.NewLinterBuilder(), lintersdb.NewPluginModuleBuilder(log), lintersdb.NewPluginGoBuilder(log))
+	if err != nil {
+		return nil, err
+	}
+
+	lintersToRun, err := dbManager.GetOptimizedLinters()
+	if err != nil {
+		return nil, err
+	}
+
+	fileCache := fsutils.NewFileCache()
+	lineCache := fsutils.NewLineCache(fileCache)
+
+	overlayFiles := make([]string, 0, len(opts.overlay))
+	for file, content := range opts.overlay {
+		fileCache.SetFileBytes(file, content)
+		cache.SetFileHash(file, sha256.Sum256(content))
+
+		// The paths of the issues are relative to the working directory after the processing of the paths.
+		if rel, err := fsutils.ShortestRelPath(file, ""); err == nil {
+			fileCache.SetFileBytes(rel, content)
+		}
+
+		overlayFiles = append(overlayFiles, file)
+	}
+
+	// The hashes of the overlay files must not be used by the next analyses.
+	defer cache.ForgetFileHash(overlayFiles...)
+
+	guard := load.NewGuard()
+
+	pkgLoader := lint.NewPackageLoader(log.Child(logutils.DebugKeyLoader), cfg, args, goenv, guard)
+	pkgLoader.SetOverlay(opts.overlay)
+	pkgLoader.SetDir(opts.dir)
+
+	lintCtx, err := lint.NewContextBuilder(cfg, pkgLoader, fileCache, pkgCache, guard).
+		Build(ctx, log.Child(logutils.DebugKeyLintersContext), lintersToRun)
+	if err != nil {
+		return nil, fmt.Errorf("context loading failed: %w", err)
+	}
+
+	// The hashes are computed for the loaded packages: the next analyses load their own packages.
+	defer pkgCache.ForgetPackageHashes(slices.Concat(lintCtx.Packages, lintCtx.OriginalPackages))
+
+	runner, err := lint.NewRunner(log.Child(logutils.DebugKeyRunner), cfg, args,
+		goenv, lineCache, fileCache, dbManager, lintCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &analysisResult{
+		reportData: reportData,
+		packages:   lintCtx.OriginalPackages,
+	}
+
+	if opts.deferOutput {
+		res.issues, res.reported, err = runner.Analyze(ctx, lintersToRun)
+	} else {
+		res.issues, err = runner.Run(ctx, lintersToRun)
+		reportData.Suppressed = runner.SuppressedIssues()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	res.exclusions = runner.Exclusions()
+
+	if opts.nolintDirectives {
+		res.directives = runner.NolintDirectives(packagesFiles(lintCtx.OriginalPackages))
+	}
+
+	return res, nil
+}
+
+// processMergedIssues processes the merged issues of several analyses for the output,
+// with the configuration of the run: the limits apply to all the issues.
+func processMergedIssues(log logutils.Log, cfg *config.Config, lineCache *fsutils.LineCache, fileCache *fsutils.FileCache,
+	res *analysisResult,
+) error {
+	// An exclusion of a configuration file shared by several analyses is unused if it matched no issue in all the analyses.
+	reported := processors.MergeUnusedExcludes(res.reported, res.exclusions)
+
+	newOutputProcessing := lint.NewOutputProcessing
+	if res.fingerprints {
+		newOutputProcessing = lint.NewFingerprintingOutputProcessing
+	}
+
+	output, err := newOutputProcessing(log.Child(logutils.DebugKeyRunner), cfg, lineCache, fileCache)
+	if err != nil {
+		return err
+	}
+
+	issues, suppressed := processors.SplitSuppressed(output.Process(res.issues, reported))
+
+	res.issues = issues
+	res.reported = nil
+	res.reportData.Suppressed = append(res.reportData.Suppressed, suppressed...)
+
+	return nil
+}
+
+// packagesFiles returns the Go files of the packages, relative to the working directory like the paths of the issues.
+func packagesFiles(pkgs []*packages.Package) []string {
+	seen := map[string]bool{}
+
+	var files []string
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.GoFiles {
+			// The test variants of a package share its files.
+			if seen[file] {
+				continue
+			}
+
+			seen[file] = true
+
+			if rel, err := fsutils.ShortestRelPath(file, ""); err == nil {
+				file = rel
+			}
+
+			files = append(files, file)
+		}
+	}
+
+	sort.Strings(files)
+
+	return files
+}
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/fsutils"
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+	"github.com/snowmerak/golangci-lint/pkg/report"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+func Test_processMergedIssues(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "main.go")
+	require.NoError(t, os.WriteFile(filePath, []byte("package main\n\nfunc a() {}\n\nfunc b() {}\n"), 0o600))
+
+	newIssue := func(line int) result.Issue {
+		return result.Issue{
+			FromLinter: "linter",
+			Text:       "same issue",
+			Pos:        token.Position{Filename: filePath, Line: line},
+		}
+	}
+
+	suppressed := newIssue(5)
+	suppressed.Suppression = &result.Suppression{Kind: result.SuppressionInSource}
+
+	// The issues of two analyses.
+	merged := &analysisResult{reportData: &report.Data{}}
+	mergeAnalysisResult(merged, &analysisResult{reportData: &report.Data{}, issues: []result.Issue{newIssue(1), newIssue(3)}})
+	mergeAnalysisResult(merged, &analysisResult{reportData: &report.Data{}, issues: []result.Issue{newIssue(4), suppressed}})
+
+	cfg := config.NewDefault()
+	cfg.Issues.MaxSameIssues = 2
+	cfg.Output.SortResults = true
+
+	fileCache := fsutils.NewFileCache()
+
+	err := processMergedIssues(logutils.NewStderrLog(logutils.DebugKeyEmpty), cfg, fsutils.NewLineCache(fileCache), fileCache, merged)
+	require.NoError(t, err)
+
+	// The limit applies to the issues of all the analyses.
+	require.Len(t, merged.issues, 2)
+	assert.Equal(t, 1, merged.issues[0].Line())
+	assert.Equal(t, 3, merged.issues[1].Line())
+
+	// The suppressed issues are not limited.
+	require.Len(t, merged.reportData.Suppressed, 1)
+	assert.Equal(t, 5, merged.reportData.Suppressed[0].Line())
+}
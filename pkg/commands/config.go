@@ -3,6 +3,7 @@ package commands
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
@@ -18,8 +19,15 @@ type configCommand struct {
 	viper *viper.Viper
 	cmd   *cobra.Command
 
-	opts       config.LoaderOptions
-	verifyOpts verifyOptions
+	opts        config.LoaderOptions
+	verifyOpts  verifyOptions
+	printOpts   printOptions
+	migrateOpts migrateOptions
+
+	printCmd *cobra.Command
+
+	cfg        *config.Config
+	provenance *config.Provenance
 
 	buildInfo BuildInfo
 
@@ -53,6 +61,28 @@ func newConfigCommand(log logutils.Log, info BuildInfo) *configCommand {
 		SilenceErrors:     true,
 	}
 
+	printCommand := &cobra.Command{
+		Use:               "print",
+		Short:             "Print the final configuration, with the origins of the values and the enabled linters",
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE:              c.executePrint,
+		SilenceUsage:      true,
+		SilenceErrors:     true,
+	}
+
+	migrateCommand := &cobra.Command{
+		Use:               "migrate",
+		Short:             "Rewrite the deprecated options of the config file to the current options",
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE:              c.executeMigrate,
+		SilenceUsage:      true,
+	}
+
+	migrateCommand.Flags().BoolVar(&c.migrateOpts.dryRun, "dry-run", false,
+		color.GreenString("Print the migrated config file instead of writing it"))
+
 	configCmd.AddCommand(
 		&cobra.Command{
 			Use:               "path",
@@ -61,6 +91,8 @@ func newConfigCommand(log logutils.Log, info BuildInfo) *configCommand {
 			ValidArgsFunction: cobra.NoFileCompletions,
 			Run:               c.executePath,
 		},
+		printCommand,
+		migrateCommand,
 		verifyCommand,
 	)
 
@@ -74,23 +106,43 @@ func newConfigCommand(log logutils.Log, info BuildInfo) *configCommand {
 	verifyFlagSet.StringVar(&c.verifyOpts.schemaURL, "schema", "", color.GreenString("JSON schema URL"))
 	_ = verifyFlagSet.MarkHidden("schema")
 
+	// The options of the run are used to compute the final configuration.
+	printFlagSet := printCommand.Flags()
+	printFlagSet.SortFlags = false // sort them as they are defined here
+
+	printFlagSet.StringVar(&c.printOpts.format, "format", printFormatYAML,
+		color.GreenString(fmt.Sprintf("Format of the configuration: %s", strings.Join(allPrintFormats, "|"))))
+
+	setupLintersFlagSet(c.viper, printFlagSet)
+	setupRunFlagSet(c.viper, printFlagSet)
+	setupOutputFlagSet(c.viper, printFlagSet)
+	setupIssuesFlagSet(c.viper, printFlagSet)
+
+	c.printCmd = printCommand
 	c.cmd = configCmd
 
 	return c
 }
 
 func (c *configCommand) preRunE(cmd *cobra.Command, args []string) error {
-	// The command doesn't depend on the real configuration.
-	// It only needs to know the path of the configuration file.
-	cfg := config.NewDefault()
+	// The commands, except print, don't depend on the real configuration.
+	// They only need to know the path of the configuration file.
+	c.cfg = config.NewDefault()
 
-	loader := config.NewLoader(c.log.Child(logutils.DebugKeyConfigReader), c.viper, cmd.Flags(), c.opts, cfg, args)
+	loader := config.NewLoader(c.log.Child(logutils.DebugKeyConfigReader), c.viper, cmd.Flags(), c.opts, c.cfg, args)
 
-	err := loader.Load(config.LoadOptions{})
+	var loadOpts config.LoadOptions
+	if cmd == c.printCmd {
+		loadOpts = config.LoadOptions{CheckDeprecation: true, Validation: true}
+	}
+
+	err := loader.Load(loadOpts)
 	if err != nil {
 		return fmt.Errorf("can't load config: %w", err)
 	}
 
+	c.provenance = loader.Provenance()
+
 	return nil
 }
 
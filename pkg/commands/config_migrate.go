@@ -0,0 +1,880 @@
+package commands
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/exitcodes"
+	"github.com/snowmerak/golangci-lint/pkg/lint/lintersdb"
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+)
+
+type migrateOptions struct {
+	dryRun bool // Flag only.
+}
+
+func (c *configCommand) executeMigrate(cmd *cobra.Command, _ []string) error {
+	usedConfigFile := c.getUsedConfig()
+	if usedConfigFile == "" {
+		c.log.Warnf("No config file detected")
+		os.Exit(exitcodes.NoConfigFileDetected)
+	}
+
+	if usedConfigFile == os.Stdin.Name() {
+		return errors.New("the configuration read from the standard input can't be migrated")
+	}
+
+	data, err := os.ReadFile(usedConfigFile)
+	if err != nil {
+		return fmt.Errorf("failed to read the configuration: %w", err)
+	}
+
+	dbManager, err := lintersdb.NewManager(c.log.Child(logutils.DebugKeyLintersDB), nil, lintersdb.NewLinterBuilder())
+	if err != nil {
+		return err
+	}
+
+	result, err := migrateConfig(data, configFormat(usedConfigFile), filepath.Dir(usedConfigFile), linterRenames(dbManager))
+	if err != nil {
+		return fmt.Errorf("[%s] migrate: %w", usedConfigFile, err)
+	}
+
+	for _, warning := range result.warnings {
+		c.log.Warnf("%s", warning)
+	}
+
+	if len(result.changes) == 0 {
+		cmd.PrintErrf("The configuration file %s is up to date.\n", usedConfigFile)
+		return nil
+	}
+
+	if c.migrateOpts.dryRun {
+		cmd.Print(string(result.data))
+	} else {
+		info, err := os.Stat(usedConfigFile)
+		if err != nil {
+			return err
+		}
+
+		err = os.WriteFile(usedConfigFile, result.data, info.Mode().Perm())
+		if err != nil {
+			return fmt.Errorf("failed to write the configuration: %w", err)
+		}
+	}
+
+	cmd.PrintErrf("Migrated the configuration file %s:\n", usedConfigFile)
+
+	for _, change := range result.changes {
+		cmd.PrintErrf("  - %s\n", change)
+	}
+
+	return nil
+}
+
+// linterRenames returns the replacements of the deprecated linters replaced by exactly one other linter.
+func linterRenames(dbManager *lintersdb.Manager) map[string]string {
+	renames := map[string]string{}
+
+	for _, lc := range dbManager.GetAllSupportedLinterConfigs() {
+		if !lc.IsDeprecated() || lc.Deprecation.Replacement == "" {
+			continue
+		}
+
+		if len(dbManager.GetLinterConfigs(lc.Deprecation.Replacement)) == 0 {
+			continue
+		}
+
+		renames[lc.Name()] = lc.Deprecation.Replacement
+	}
+
+	return renames
+}
+
+func configFormat(file string) string {
+	switch ext := strings.TrimPrefix(filepath.Ext(file), "."); ext {
+	case "toml", "json":
+		return ext
+	default:
+		return "yaml"
+	}
+}
+
+type migrateResult struct {
+	data []byte
+
+	// The descriptions of the changes.
+	changes []string
+	// The deprecated options which cannot be migrated automatically.
+	warnings []string
+}
+
+// migrateConfig rewrites a configuration to the current schema.
+// The comments are kept for YAML; JSON has no comments, and the comments of TOML are lost.
+func migrateConfig(data []byte, format, configDir string, renames map[string]string) (*migrateResult, error) {
+	var (
+		root *yaml.Node
+		err  error
+	)
+
+	if format == "toml" {
+		root, err = tomlToNode(data)
+	} else {
+		root, err = yamlToNode(data)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	m := &configMigrator{root: root, configDir: configDir, renames: renames}
+	m.migrate()
+
+	result := &migrateResult{changes: m.changes, warnings: m.warnings}
+
+	switch format {
+	case "toml":
+		buf := &bytes.Buffer{}
+		newNodeValue(root).writeTOMLTable(buf, "")
+		result.data = buf.Bytes()
+
+	case "json":
+		raw, err := newNodeValue(root).MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+
+		buf := &bytes.Buffer{}
+		if err := json.Indent(buf, raw, "", "  "); err != nil {
+			return nil, err
+		}
+
+		buf.WriteString("\n")
+		result.data = buf.Bytes()
+
+	default:
+		buf := &bytes.Buffer{}
+
+		encoder := yaml.NewEncoder(buf)
+		encoder.SetIndent(2)
+
+		if err := encoder.Encode(&yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{root}}); err != nil {
+			return nil, err
+		}
+
+		if err := encoder.Close(); err != nil {
+			return nil, err
+		}
+
+		result.data = buf.Bytes()
+	}
+
+	return result, nil
+}
+
+func yamlToNode(data []byte) (*yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	if len(doc.Content) == 0 {
+		return &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}, nil
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, errors.New("the configuration is not a map")
+	}
+
+	// The comments of the document are kept on the root node.
+	root.HeadComment = strings.TrimSpace(doc.HeadComment + "\n" + root.HeadComment)
+	root.FootComment = strings.TrimSpace(root.FootComment + "\n" + doc.FootComment)
+
+	return root, nil
+}
+
+// tomlToNode converts a TOML document into a YAML node, with the order of the keys of the document.
+func tomlToNode(data []byte) (*yaml.Node, error) {
+	var values map[string]any
+
+	md, err := toml.Decode(string(data), &values)
+	if err != nil {
+		return nil, err
+	}
+
+	// The tables defined by their sub-tables (ex: `[[issues.exclude-rules]]`) have the order of their first sub-table.
+	order := map[string]int{}
+	for i, key := range md.Keys() {
+		for j := range key {
+			k := strings.Join(key[:j+1], ".")
+			if _, ok := order[k]; !ok {
+				order[k] = i
+			}
+		}
+	}
+
+	return valueToNode(values, "", order)
+}
+
+func valueToNode(value any, path string, order map[string]int) (*yaml.Node, error) {
+	switch v := value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+
+		slices.SortFunc(keys, func(a, b string) int {
+			return order[childKey(path, a)] - order[childKey(path, b)]
+		})
+
+		node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+
+		for _, k := range keys {
+			child, err := valueToNode(v[k], childKey(path, k), order)
+			if err != nil {
+				return nil, err
+			}
+
+			node.Content = append(node.Content, newScalarNode(k), child)
+		}
+
+		return node, nil
+
+	case []map[string]any:
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+
+		for _, item := range v {
+			child, err := valueToNode(item, path, order)
+			if err != nil {
+				return nil, err
+			}
+
+			node.Content = append(node.Content, child)
+		}
+
+		return node, nil
+
+	case []any:
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+
+		for _, item := range v {
+			child, err := valueToNode(item, path, order)
+			if err != nil {
+				return nil, err
+			}
+
+			node.Content = append(node.Content, child)
+		}
+
+		return node, nil
+
+	default:
+		node := &yaml.Node{}
+		if err := node.Encode(v); err != nil {
+			return nil, err
+		}
+
+		return node, nil
+	}
+}
+
+// newNodeValue returns the printed value of a YAML node, without origins.
+func newNodeValue(node *yaml.Node) *printedValue {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+
+	v := &printedValue{}
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		v.kind = printedMap
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			child := newNodeValue(node.Content[i+1])
+			child.name = node.Content[i].Value
+
+			v.children = append(v.children, child)
+		}
+
+	case yaml.SequenceNode:
+		v.kind = printedList
+
+		for _, item := range node.Content {
+			v.children = append(v.children, newNodeValue(item))
+		}
+
+	default:
+		_ = node.Decode(&v.scalar)
+	}
+
+	return v
+}
+
+func newScalarNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
+
+func newBoolNode(value bool) *yaml.Node {
+	node := &yaml.Node{}
+	_ = node.Encode(value)
+
+	return node
+}
+
+// configMigrator rewrites the deprecated options of a configuration.
+// The keys are matched case-insensitively, like the configuration loader does.
+type configMigrator struct {
+	root *yaml.Node
+
+	// The directory of the configuration file, to read the files referenced by the configuration.
+	configDir string
+
+	// The replacements of the deprecated linters.
+	renames map[string]string
+
+	changes  []string
+	warnings []string
+}
+
+func (m *configMigrator) migrate() {
+	// Deprecated since v1.57.0
+	m.move("run.skip-files", "issues.exclude-files")
+	m.move("run.skip-dirs", "issues.exclude-dirs")
+
+	// The 2 options are true by default.
+	// Deprecated since v1.57.0
+	if key, value := m.removeKey("run.skip-dirs-use-default"); value != nil {
+		if value.Value == "false" {
+			m.replace(key, value, "issues.exclude-dirs-use-default", newBoolNode(false))
+		}
+
+		m.changef("`run.skip-dirs-use-default` moved to `issues.exclude-dirs-use-default`")
+	}
+
+	// The 2 options are false by default.
+	// Deprecated since v1.57.0
+	if key, value := m.removeKey("run.show-stats"); value != nil {
+		if value.Value == "true" {
+			m.replace(key, value, "output.show-stats", newBoolNode(true))
+		}
+
+		m.changef("`run.show-stats` moved to `output.show-stats`")
+	}
+
+	m.migrateOutputFormat()
+
+	// Deprecated since v1.59.0
+	if key, value := m.removeKey("issues.exclude-generated-strict"); value != nil {
+		if value.Value == "true" {
+			m.replace(key, value, "issues.exclude-generated", newScalarNode("strict"))
+		}
+
+		m.changef("`issues.exclude-generated-strict` replaced by `issues.exclude-generated: strict`")
+	}
+
+	m.migrateLinterSettings()
+	m.migrateLinterNames()
+}
+
+func (m *configMigrator) migrateOutputFormat() {
+	// Deprecated since v1.57.0
+	// The new option is set before the removal of the old option to keep the position of the section.
+	if value := m.get("output.format"); value != nil {
+		var formats config.OutputFormats
+		_ = formats.UnmarshalText([]byte(value.Value))
+
+		list := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+
+		for _, format := range formats {
+			item := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			item.Content = append(item.Content, newScalarNode("format"), newScalarNode(format.Format))
+
+			if format.Path != "" {
+				item.Content = append(item.Content, newScalarNode("path"), newScalarNode(format.Path))
+			}
+
+			list.Content = append(list.Content, item)
+		}
+
+		m.set("output.formats", list)
+
+		key, _ := m.removeKey("output.format")
+		moveComments(m.keyNode("output.formats"), key, value)
+		m.changef("`output.format` replaced by `output.formats`")
+	}
+
+	formats := m.get("output.formats")
+	if formats == nil || formats.Kind != yaml.SequenceNode {
+		return
+	}
+
+	for _, item := range formats.Content {
+		format := mappingValue(item, "format")
+		if format != nil && format.Value == config.OutFormatGithubActions {
+			format.Value = config.OutFormatColoredLineNumber
+			m.changef("output format `%s` replaced by `%s`", config.OutFormatGithubActions, config.OutFormatColoredLineNumber)
+		}
+	}
+}
+
+//nolint:gocyclo // the complexity cannot be reduced.
+func (m *configMigrator) migrateLinterSettings() {
+	// Deprecated since v1.57.0
+	if key, value := m.removeKey("linters-settings.govet.check-shadowing"); value != nil {
+		if value.Value == "true" {
+			if added := m.appendValues("linters-settings.govet.enable", "shadow"); len(added) > 0 {
+				moveComments(added[0], key, value)
+			}
+		}
+
+		m.changef("`linters-settings.govet.check-shadowing` replaced by the analyzer `shadow` in `linters-settings.govet.enable`")
+	}
+
+	if m.remove("linters-settings.copyloopvar.ignore-alias") != nil {
+		m.changef("`linters-settings.copyloopvar.ignore-alias` removed: the option is ignored")
+	}
+
+	// Deprecated since v1.42.0.
+	if value := m.get("linters-settings.errcheck.exclude"); value != nil {
+		functions, err := readExcludeFunctions(m.configDir, value.Value)
+		if err != nil {
+			m.warnf("`linters-settings.errcheck.exclude` cannot be migrated: %v", err)
+		} else {
+			key, _ := m.removeKey("linters-settings.errcheck.exclude")
+			m.appendValues("linters-settings.errcheck.exclude-functions", functions...)
+			moveComments(m.keyNode("linters-settings.errcheck.exclude-functions"), key, value)
+			m.changef("`linters-settings.errcheck.exclude` replaced by the content of the file in `linters-settings.errcheck.exclude-functions`")
+		}
+	}
+
+	// Deprecated since v1.59.0
+	if m.get("linters-settings.errcheck.ignore") != nil {
+		m.warnf("`linters-settings.errcheck.ignore` cannot be migrated automatically: " +
+			"please list the functions in `linters-settings.errcheck.exclude-functions`")
+	}
+
+	// Deprecated since v1.44.0.
+	if key, value := m.removeKey("linters-settings.gci.local-prefixes"); value != nil {
+		if m.get("linters-settings.gci.sections") == nil {
+			m.appendValues("linters-settings.gci.sections", "standard", "default")
+		}
+
+		var sections []string
+		for _, prefix := range strings.Split(value.Value, ",") {
+			if prefix = strings.TrimSpace(prefix); prefix != "" {
+				sections = append(sections, fmt.Sprintf("prefix(%s)", prefix))
+			}
+		}
+
+		m.appendValues("linters-settings.gci.sections", sections...)
+		moveComments(m.keyNode("linters-settings.gci.sections"), key, value)
+		m.changef("`linters-settings.gci.local-prefixes` replaced by `prefix()` in `linters-settings.gci.sections`")
+	}
+
+	// Deprecated since v1.33.0.
+	if key, value := m.removeKey("linters-settings.godot.check-all"); value != nil {
+		if value.Value == "true" {
+			m.replace(key, value, "linters-settings.godot.scope", newScalarNode("all"))
+		}
+
+		m.changef("`linters-settings.godot.check-all` replaced by `linters-settings.godot.scope: all`")
+	}
+
+	m.migrateGomndSettings()
+
+	// Deprecated since v1.47.0
+	for _, key := range []string{
+		"linters-settings.gofumpt.lang-version",
+		"linters-settings.staticcheck.go",
+		"linters-settings.gosimple.go",
+		"linters-settings.stylecheck.go",
+	} {
+		keyNode, value := m.removeKey(key)
+		if value == nil {
+			continue
+		}
+
+		if m.get("run.go") == nil {
+			m.replace(keyNode, value, "run.go", value)
+		}
+
+		m.changef("`%s` replaced by `run.go`", key)
+	}
+
+	// Deprecated since v1.58.0
+	if key, value := m.removeKey("linters-settings.sloglint.context-only"); value != nil {
+		if value.Value == "true" && m.get("linters-settings.sloglint.context") == nil {
+			m.replace(key, value, "linters-settings.sloglint.context", newScalarNode("all"))
+		}
+
+		m.changef("`linters-settings.sloglint.context-only` replaced by `linters-settings.sloglint.context: all`")
+	}
+
+	// Deprecated since v1.51.0
+	for _, key := range []string{"linters-settings.usestdlibvars.os-dev-null", "linters-settings.usestdlibvars.syslog-priority"} {
+		if m.remove(key) != nil {
+			m.changef("`%s` removed: the option is ignored", key)
+		}
+	}
+}
+
+// migrateGomndSettings moves the settings of the linter gomnd, renamed mnd.
+func (m *configMigrator) migrateGomndSettings() {
+	gomnd := m.get("linters-settings.gomnd")
+	if gomnd == nil || gomnd.Kind != yaml.MappingNode {
+		return
+	}
+
+	// Deprecated since v1.44.0.
+	if settings := m.get("linters-settings.gomnd.settings"); settings != nil {
+		if mnd := mappingValue(settings, "mnd"); mnd != nil && mnd.Kind == yaml.MappingNode {
+			for i := 0; i+1 < len(mnd.Content); i += 2 {
+				path := "linters-settings.gomnd." + strings.ToLower(mnd.Content[i].Value)
+				m.replace(mnd.Content[i], mnd.Content[i+1], path, splitList(mnd.Content[i+1]))
+			}
+		}
+
+		// The comments of the removed sections are moved to the section of the linter.
+		mndKey := m.keyNode("linters-settings.gomnd.settings.mnd")
+		key, _ := m.removeKey("linters-settings.gomnd.settings")
+		moveComments(m.keyNode("linters-settings.gomnd"), key, mndKey)
+
+		m.changef("`linters-settings.gomnd.settings` replaced by `linters-settings.mnd`")
+	}
+
+	// Deprecated since v1.58.0
+	if m.get("linters-settings.mnd") != nil {
+		m.warnf("`linters-settings.gomnd` cannot be migrated: `linters-settings.mnd` is already defined")
+		return
+	}
+
+	m.move("linters-settings.gomnd", "linters-settings.mnd")
+}
+
+// migrateLinterNames replaces the names of the deprecated linters by the names of their replacements.
+func (m *configMigrator) migrateLinterNames() {
+	lists := []*yaml.Node{m.get("linters.enable"), m.get("linters.disable")}
+
+	for _, section := range []string{"issues.exclude-rules", "severity.rules"} {
+		rules := m.get(section)
+		if rules == nil || rules.Kind != yaml.SequenceNode {
+			continue
+		}
+
+		for _, rule := range rules.Content {
+			lists = append(lists, mappingValue(rule, "linters"))
+		}
+	}
+
+	for _, list := range lists {
+		if list == nil || list.Kind != yaml.SequenceNode {
+			continue
+		}
+
+		seen := map[string]bool{}
+
+		var content []*yaml.Node
+
+		for _, item := range list.Content {
+			if replacement, ok := m.renames[item.Value]; ok {
+				m.changef("linter `%s` replaced by `%s`", item.Value, replacement)
+				item.Value = replacement
+			}
+
+			if seen[item.Value] {
+				continue
+			}
+
+			seen[item.Value] = true
+
+			content = append(content, item)
+		}
+
+		list.Content = content
+	}
+}
+
+// move moves the value of a key to another key.
+// The value replaces the value of the other key, like the loader does with the deprecated options.
+func (m *configMigrator) move(from, to string) {
+	key, value := m.removeKey(from)
+	if value == nil {
+		return
+	}
+
+	// The comments of the value are moved with the value.
+	m.replace(key, value, to, value)
+
+	m.changef("`%s` moved to `%s`", from, to)
+}
+
+// replace sets the value of a key in place of a removed key: the comments of the removed key are moved to the new key.
+func (m *configMigrator) replace(oldKey, oldValue *yaml.Node, path string, value *yaml.Node) {
+	m.set(path, value)
+
+	if oldValue == value {
+		oldValue = nil
+	}
+
+	moveComments(m.keyNode(path), oldKey, oldValue)
+}
+
+func (m *configMigrator) get(path string) *yaml.Node {
+	parent, key := m.lookup(path)
+	if key == nil {
+		return nil
+	}
+
+	return mappingValue(parent, key.Value)
+}
+
+// keyNode returns the node of a key, or nil.
+func (m *configMigrator) keyNode(path string) *yaml.Node {
+	_, key := m.lookup(path)
+	return key
+}
+
+// remove removes a key, and returns its value or nil.
+func (m *configMigrator) remove(path string) *yaml.Node {
+	_, value := m.removeKey(path)
+	return value
+}
+
+// removeKey removes a key, and returns the key and the value, or nil.
+// The parent maps left empty by the removal are removed: their comments are kept on the returned key.
+func (m *configMigrator) removeKey(path string) (keyNode, value *yaml.Node) {
+	parent, key := m.lookup(path)
+	if key == nil {
+		return nil, nil
+	}
+
+	for i := 0; i+1 < len(parent.Content); i += 2 {
+		if parent.Content[i] == key {
+			value = parent.Content[i+1]
+			parent.Content = slices.Delete(parent.Content, i, i+2)
+
+			break
+		}
+	}
+
+	if len(parent.Content) == 0 && parent != m.root {
+		if i := strings.LastIndexByte(path, '.'); i >= 0 {
+			parentKey, _ := m.removeKey(path[:i])
+
+			key.HeadComment = joinComments("\n", parentKey.HeadComment, parentKey.LineComment, key.HeadComment)
+			key.FootComment = joinComments("\n", key.FootComment, parentKey.FootComment)
+		}
+	}
+
+	return key, value
+}
+
+// set sets the value of a key, and creates the missing parent maps.
+func (m *configMigrator) set(path string, value *yaml.Node) {
+	node := m.root
+
+	parts := strings.Split(path, ".")
+
+	for i, part := range parts {
+		child := mappingValue(node, part)
+
+		if i == len(parts)-1 {
+			if child != nil {
+				*child = *value
+				return
+			}
+
+			node.Content = append(node.Content, newScalarNode(part), value)
+
+			return
+		}
+
+		if child == nil || child.Kind != yaml.MappingNode {
+			child = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			node.Content = append(node.Content, newScalarNode(part), child)
+		}
+
+		node = child
+	}
+}
+
+// appendValues appends string values to a list, and creates the list if needed.
+// It returns the nodes of the appended values: the values already in the list are not appended.
+func (m *configMigrator) appendValues(path string, values ...string) []*yaml.Node {
+	list := m.get(path)
+	if list == nil || list.Kind != yaml.SequenceNode {
+		list = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		m.set(path, list)
+		list = m.get(path)
+	}
+
+	var added []*yaml.Node
+
+	for _, value := range values {
+		if !slices.ContainsFunc(list.Content, func(n *yaml.Node) bool { return n.Value == value }) {
+			node := newScalarNode(value)
+
+			list.Content = append(list.Content, node)
+			added = append(added, node)
+		}
+	}
+
+	return added
+}
+
+// lookup returns the map containing the key, and the node of the key or nil.
+func (m *configMigrator) lookup(path string) (parent, key *yaml.Node) {
+	node := m.root
+
+	parts := strings.Split(path, ".")
+
+	for i, part := range parts {
+		if node == nil || node.Kind != yaml.MappingNode {
+			return nil, nil
+		}
+
+		index := keyIndex(node, part)
+		if index < 0 {
+			return nil, nil
+		}
+
+		if i == len(parts)-1 {
+			return node, node.Content[index]
+		}
+
+		node = node.Content[index+1]
+	}
+
+	return nil, nil
+}
+
+func (m *configMigrator) changef(format string, args ...any) {
+	change := fmt.Sprintf(format, args...)
+
+	if !slices.Contains(m.changes, change) {
+		m.changes = append(m.changes, change)
+	}
+}
+
+func (m *configMigrator) warnf(format string, args ...any) {
+	m.warnings = append(m.warnings, fmt.Sprintf(format, args...))
+}
+
+// keyIndex returns the index of the key in the content of a map, or -1.
+func keyIndex(node *yaml.Node, key string) int {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if strings.EqualFold(node.Content[i].Value, key) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// mappingValue returns the value of a key of a map, or nil.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	index := keyIndex(node, key)
+	if index < 0 {
+		return nil
+	}
+
+	value := node.Content[index+1]
+	if value.Kind == yaml.AliasNode {
+		return value.Alias
+	}
+
+	return value
+}
+
+// moveComments appends the comments of the nodes of a removed key (the key and its value) to a node.
+func moveComments(dst *yaml.Node, nodes ...*yaml.Node) {
+	if dst == nil {
+		return
+	}
+
+	for _, node := range nodes {
+		if node == nil {
+			continue
+		}
+
+		dst.HeadComment = joinComments("\n", dst.HeadComment, node.HeadComment)
+		dst.LineComment = joinComments(" ", dst.LineComment, node.LineComment)
+		dst.FootComment = joinComments("\n", dst.FootComment, node.FootComment)
+	}
+}
+
+// joinComments joins the non-empty comments.
+func joinComments(sep string, comments ...string) string {
+	var parts []string
+
+	for _, comment := range comments {
+		if comment != "" {
+			parts = append(parts, comment)
+		}
+	}
+
+	return strings.Join(parts, sep)
+}
+
+// splitList converts a comma-separated list (the old format of some options) into a list.
+func splitList(node *yaml.Node) *yaml.Node {
+	if node.Kind != yaml.ScalarNode || node.Tag != "!!str" {
+		return node
+	}
+
+	list := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+
+	for _, value := range strings.Split(node.Value, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			list.Content = append(list.Content, newScalarNode(value))
+		}
+	}
+
+	return list
+}
+
+// readExcludeFunctions reads the functions of an exclusion file of errcheck: one function per line.
+func readExcludeFunctions(configDir, path string) ([]string, error) {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(configDir, path)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = file.Close() }()
+
+	var functions []string
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+
+		functions = append(functions, line)
+	}
+
+	return functions, scanner.Err()
+}
@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_migrateConfig(t *testing.T) {
+	renames := map[string]string{
+		"deadcode":    "unused",
+		"golint":      "revive",
+		"gomnd":       "mnd",
+		"scopelint":   "exportloopref",
+		"structcheck": "unused",
+		"varcheck":    "unused",
+	}
+
+	testDir := filepath.Join("testdata", "migrate")
+
+	entries, err := os.ReadDir(testDir)
+	require.NoError(t, err)
+
+	for _, entry := range entries {
+		name := entry.Name()
+
+		ext := filepath.Ext(name)
+		if ext == ".txt" || strings.HasSuffix(strings.TrimSuffix(name, ext), ".golden") {
+			continue
+		}
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := os.ReadFile(filepath.Join(testDir, name))
+			require.NoError(t, err)
+
+			result, err := migrateConfig(data, configFormat(name), testDir, renames)
+			require.NoError(t, err)
+
+			golden := filepath.Join(testDir, strings.TrimSuffix(name, ext)+".golden"+ext)
+
+			expected, err := os.ReadFile(golden)
+			require.NoError(t, err)
+
+			assert.Equal(t, string(expected), string(result.data))
+
+			if name == "up-to-date.yml" {
+				assert.Empty(t, result.changes)
+			} else {
+				assert.NotEmpty(t, append(result.changes, result.warnings...))
+			}
+		})
+	}
+}
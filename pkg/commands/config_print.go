@@ -0,0 +1,541 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/lint/lintersdb"
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+)
+
+// Formats of the printed configuration.
+const (
+	printFormatYAML = "yaml"
+	printFormatJSON = "json"
+	printFormatTOML = "toml"
+)
+
+var allPrintFormats = []string{printFormatYAML, printFormatJSON, printFormatTOML}
+
+type printOptions struct {
+	format string // Flag only.
+}
+
+func (c *configCommand) executePrint(cmd *cobra.Command, _ []string) error {
+	if !slices.Contains(allPrintFormats, c.printOpts.format) {
+		return fmt.Errorf("unknown format %q: only %s are allowed", c.printOpts.format, strings.Join(allPrintFormats, ", "))
+	}
+
+	dbManager, err := lintersdb.NewManager(c.log.Child(logutils.DebugKeyLintersDB), c.cfg,
+		lintersdb.NewLinterBuilder(), lintersdb.NewPluginModuleBuilder(c.log), lintersdb.NewPluginGoBuilder(c.log))
+	if err != nil {
+		return err
+	}
+
+	printed := newPrintedConfig(c.cfg, c.provenance, dbManager)
+
+	switch c.printOpts.format {
+	case printFormatJSON:
+		return printed.printJSON(cmd.OutOrStdout())
+	case printFormatTOML:
+		return printed.printTOML(cmd.OutOrStdout())
+	default:
+		return printed.printYAML(cmd.OutOrStdout())
+	}
+}
+
+// printedConfig is the final configuration of a run, with the origins of its values and the enabled linters.
+type printedConfig struct {
+	root *printedValue
+
+	linters []printedLinter
+}
+
+type printedLinter struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+	Preset string `json:"preset,omitempty"`
+}
+
+func newPrintedConfig(cfg *config.Config, provenance *config.Provenance, dbManager *lintersdb.Manager) *printedConfig {
+	printed := &printedConfig{
+		root: newPrintedValue(reflect.ValueOf(cfg).Elem(), "", provenance),
+	}
+
+	for name, reason := range dbManager.GetEnabledLintersReasons() {
+		lcs := dbManager.GetLinterConfigs(name)
+		if len(lcs) > 0 && lcs[0].Internal {
+			continue
+		}
+
+		printed.linters = append(printed.linters, printedLinter{Name: name, Reason: reason.Kind, Preset: reason.Preset})
+	}
+
+	slices.SortFunc(printed.linters, func(a, b printedLinter) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return printed
+}
+
+func (p *printedConfig) printYAML(w io.Writer) error {
+	doc := &yaml.Node{
+		Kind:        yaml.DocumentNode,
+		Content:     []*yaml.Node{p.root.yamlNode()},
+		FootComment: p.lintersComment(),
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("failed to print the configuration: %w", err)
+	}
+
+	return encoder.Close()
+}
+
+func (p *printedConfig) printJSON(w io.Writer) error {
+	sources := map[string]string{}
+	p.root.collectSources(sources)
+
+	data := struct {
+		Config         *printedValue     `json:"config"`
+		Sources        map[string]string `json:"sources"`
+		EnabledLinters []printedLinter   `json:"enabled-linters"`
+	}{
+		Config:         p.root,
+		Sources:        sources,
+		EnabledLinters: p.linters,
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(data)
+}
+
+func (p *printedConfig) printTOML(w io.Writer) error {
+	buf := &bytes.Buffer{}
+
+	p.root.writeTOMLTable(buf, "")
+
+	buf.WriteString("\n")
+
+	for _, line := range strings.Split(p.lintersComment(), "\n") {
+		buf.WriteString("# " + line + "\n")
+	}
+
+	_, err := w.Write(buf.Bytes())
+
+	return err
+}
+
+func (p *printedConfig) lintersComment() string {
+	lines := []string{fmt.Sprintf("Enabled linters (%d):", len(p.linters))}
+
+	for _, lnt := range p.linters {
+		reason := lnt.Reason
+		if lnt.Preset != "" {
+			reason += " " + lnt.Preset
+		}
+
+		lines = append(lines, fmt.Sprintf("  %s: %s", lnt.Name, reason))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+type printedKind int
+
+const (
+	printedScalar printedKind = iota
+	printedMap
+	printedList
+)
+
+// printedValue is a value of the printed configuration.
+// The fields of the maps keep the order of the fields of the configuration structures.
+type printedValue struct {
+	kind printedKind
+
+	name string
+	// The dotted key of the value, or an empty string for the values inside the lists.
+	key string
+
+	source config.Source
+
+	scalar   any
+	children []*printedValue
+}
+
+// newPrintedValue returns the printed value of a configuration value.
+// Without provenance (the elements of the lists), the values are not annotated with their origins.
+func newPrintedValue(rv reflect.Value, key string, provenance *config.Provenance) *printedValue {
+	v := &printedValue{}
+
+	if provenance != nil && key != "" {
+		v.key = key
+		v.source = provenance.Source(key)
+	}
+
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return v
+		}
+
+		rv = rv.Elem()
+	}
+
+	if d, ok := rv.Interface().(time.Duration); ok {
+		v.scalar = d.String()
+		return v
+	}
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		v.kind = printedMap
+		v.children = structChildren(rv, key, provenance)
+
+	case reflect.Map:
+		v.kind = printedMap
+
+		keys := rv.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int {
+			return strings.Compare(fmt.Sprint(a.Interface()), fmt.Sprint(b.Interface()))
+		})
+
+		for _, k := range keys {
+			name := fmt.Sprint(k.Interface())
+
+			child := newPrintedValue(rv.MapIndex(k), childKey(key, name), provenance)
+			child.name = name
+
+			v.children = append(v.children, child)
+		}
+
+	case reflect.Slice, reflect.Array:
+		v.kind = printedList
+
+		for i := range rv.Len() {
+			// The elements of the lists have the origin of the lists.
+			v.children = append(v.children, newPrintedValue(rv.Index(i), "", nil))
+		}
+
+	default:
+		v.scalar = rv.Interface()
+	}
+
+	return v
+}
+
+// structChildren returns the fields of a configuration structure, named like the configuration keys.
+func structChildren(rv reflect.Value, key string, provenance *config.Provenance) []*printedValue {
+	var children []*printedValue
+
+	for i := range rv.NumField() {
+		field := rv.Type().Field(i)
+
+		// The internal options are only used by the tests.
+		if !field.IsExported() || strings.HasPrefix(field.Name, "Internal") {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if opts == "squash" {
+			children = append(children, structChildren(rv.Field(i), key, provenance)...)
+			continue
+		}
+
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+
+		child := newPrintedValue(rv.Field(i), childKey(key, name), provenance)
+		child.name = name
+
+		children = append(children, child)
+	}
+
+	return children
+}
+
+func childKey(key, name string) string {
+	if key == "" {
+		return name
+	}
+
+	return key + "." + name
+}
+
+func (v *printedValue) annotated() bool {
+	return v.key != "" && v.kind != printedMap
+}
+
+func (v *printedValue) collectSources(sources map[string]string) {
+	if v.annotated() {
+		sources[v.key] = v.source.String()
+	}
+
+	if v.kind == printedMap {
+		for _, child := range v.children {
+			child.collectSources(sources)
+		}
+	}
+}
+
+func (v *printedValue) yamlNode() *yaml.Node {
+	var node *yaml.Node
+
+	switch v.kind {
+	case printedMap:
+		node = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+
+		for _, child := range v.children {
+			keyNode := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: child.name}
+			valueNode := child.yamlNode()
+
+			// The comment of a block is printed after the key.
+			if child.kind == printedList && len(child.children) > 0 && child.annotated() {
+				keyNode.LineComment, valueNode.LineComment = valueNode.LineComment, ""
+			}
+
+			node.Content = append(node.Content, keyNode, valueNode)
+		}
+
+		if len(v.children) == 0 {
+			node.Style = yaml.FlowStyle
+		}
+
+	case printedList:
+		node = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+
+		for _, child := range v.children {
+			node.Content = append(node.Content, child.yamlNode())
+		}
+
+		if len(v.children) == 0 {
+			node.Style = yaml.FlowStyle
+		}
+
+	default:
+		node = &yaml.Node{}
+		if err := node.Encode(v.scalar); err != nil {
+			node = &yaml.Node{Kind: yaml.ScalarNode, Value: fmt.Sprint(v.scalar)}
+		}
+	}
+
+	if v.annotated() {
+		node.LineComment = v.source.String()
+	}
+
+	return node
+}
+
+// MarshalJSON marshals the value with the order of the fields of the configuration structures.
+func (v *printedValue) MarshalJSON() ([]byte, error) {
+	switch v.kind {
+	case printedMap:
+		buf := &bytes.Buffer{}
+		buf.WriteString("{")
+
+		for i, child := range v.children {
+			if i > 0 {
+				buf.WriteString(",")
+			}
+
+			name, err := json.Marshal(child.name)
+			if err != nil {
+				return nil, err
+			}
+
+			value, err := child.MarshalJSON()
+			if err != nil {
+				return nil, err
+			}
+
+			buf.Write(name)
+			buf.WriteString(":")
+			buf.Write(value)
+		}
+
+		buf.WriteString("}")
+
+		return buf.Bytes(), nil
+
+	case printedList:
+		values := make([]json.RawMessage, 0, len(v.children))
+
+		for _, child := range v.children {
+			value, err := child.MarshalJSON()
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, value)
+		}
+
+		return json.Marshal(values)
+
+	default:
+		return json.Marshal(v.scalar)
+	}
+}
+
+// writeTOMLTable writes the fields of a map as a TOML table:
+// the key/value pairs first, then the sub-tables and the arrays of tables.
+// TOML has no null value: the unset values are omitted.
+func (v *printedValue) writeTOMLTable(buf *bytes.Buffer, table string) {
+	for _, child := range v.children {
+		if child.isTOMLTable() || child.isTOMLArrayOfTables() || (child.kind == printedScalar && child.scalar == nil) {
+			continue
+		}
+
+		buf.WriteString(tomlKeyName(child.name) + " = " + child.tomlValue())
+
+		if child.annotated() {
+			buf.WriteString(" # " + child.source.String())
+		}
+
+		buf.WriteString("\n")
+	}
+
+	for _, child := range v.children {
+		path := childTOMLTable(table, child.name)
+
+		switch {
+		case child.isTOMLTable():
+			writeTOMLHeader(buf, "["+path+"]")
+			child.writeTOMLTable(buf, path)
+
+		case child.isTOMLArrayOfTables():
+			header := "[[" + path + "]]"
+			if child.annotated() {
+				header += " # " + child.source.String()
+			}
+
+			for _, item := range child.children {
+				writeTOMLHeader(buf, header)
+				item.writeTOMLTable(buf, path)
+			}
+		}
+	}
+}
+
+func writeTOMLHeader(buf *bytes.Buffer, header string) {
+	if buf.Len() > 0 {
+		buf.WriteString("\n")
+	}
+
+	buf.WriteString(header + "\n")
+}
+
+func (v *printedValue) isTOMLTable() bool {
+	return v.kind == printedMap
+}
+
+func (v *printedValue) isTOMLArrayOfTables() bool {
+	if v.kind != printedList || len(v.children) == 0 {
+		return false
+	}
+
+	for _, child := range v.children {
+		if child.kind != printedMap {
+			return false
+		}
+	}
+
+	return true
+}
+
+// tomlValue returns the value as an inline TOML value.
+func (v *printedValue) tomlValue() string {
+	switch v.kind {
+	case printedMap:
+		var fields []string
+
+		for _, child := range v.children {
+			if child.kind == printedScalar && child.scalar == nil {
+				continue
+			}
+
+			fields = append(fields, tomlKeyName(child.name)+" = "+child.tomlValue())
+		}
+
+		return "{ " + strings.Join(fields, ", ") + " }"
+
+	case printedList:
+		var values []string
+
+		for _, child := range v.children {
+			values = append(values, child.tomlValue())
+		}
+
+		return "[" + strings.Join(values, ", ") + "]"
+
+	default:
+		return tomlScalar(v.scalar)
+	}
+}
+
+func tomlScalar(value any) string {
+	rv := reflect.ValueOf(value)
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool())
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+
+	case reflect.Float32, reflect.Float64:
+		s := strconv.FormatFloat(rv.Float(), 'f', -1, 64)
+		if !strings.Contains(s, ".") {
+			s += ".0"
+		}
+
+		return s
+
+	default:
+		// The escape sequences of JSON strings are valid inside TOML basic strings.
+		s, _ := json.Marshal(fmt.Sprint(value))
+		return string(s)
+	}
+}
+
+var tomlBareKey = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+func tomlKeyName(name string) string {
+	if tomlBareKey.MatchString(name) {
+		return name
+	}
+
+	return strconv.Quote(name)
+}
+
+func childTOMLTable(table, name string) string {
+	if table == "" {
+		return tomlKeyName(name)
+	}
+
+	return table + "." + tomlKeyName(name)
+}
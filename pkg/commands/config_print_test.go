@@ -0,0 +1,171 @@
+package commands
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/snowmerak/golangci-lint/pkg/config"
+)
+
+type printedTestRule struct {
+	Path    string   `mapstructure:"path"`
+	Linters []string `mapstructure:"linters"`
+}
+
+type PrintedTestEmbedded struct {
+	Text string `mapstructure:"text"`
+}
+
+type printedTestConfig struct {
+	Timeout  time.Duration     `mapstructure:"timeout"`
+	Tests    bool              `mapstructure:"tests"`
+	Go       string            `mapstructure:"-"`
+	Limit    *int              `mapstructure:"limit"`
+	Rules    []printedTestRule `mapstructure:"rules"`
+	Settings map[string]any    `mapstructure:"settings"`
+	Lll      struct{ LineLength int }
+
+	PrintedTestEmbedded `mapstructure:",squash"`
+
+	InternalTest bool
+}
+
+func newPrintedTestConfig(t *testing.T) *printedConfig {
+	t.Helper()
+
+	cfg := printedTestConfig{
+		Timeout:  5 * time.Minute,
+		Tests:    true,
+		Go:       "1.22",
+		Rules:    []printedTestRule{{Path: "_test.go", Linters: []string{"errcheck"}}},
+		Settings: map[string]any{"b": 2, "a": "x"},
+	}
+	cfg.Lll.LineLength = 120
+	cfg.Text = "foo"
+
+	provenance := config.NewProvenance()
+	provenance.Set("timeout", config.Source{Kind: config.SourceFlag, Name: "timeout"})
+	provenance.Set("rules", config.Source{Kind: config.SourceFile, Name: ".golangci.yml", Line: 4})
+
+	return &printedConfig{
+		root:    newPrintedValue(reflect.ValueOf(cfg), "", provenance),
+		linters: []printedLinter{{Name: "errcheck", Reason: "default"}, {Name: "unused", Reason: "preset", Preset: "unused"}},
+	}
+}
+
+func Test_printedConfig_printYAML(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	err := newPrintedTestConfig(t).printYAML(buf)
+	require.NoError(t, err)
+
+	expected := `timeout: 5m0s # flag --timeout
+tests: true # default
+limit: null # default
+rules: # .golangci.yml:4
+  - path: _test.go
+    linters:
+      - errcheck
+settings:
+  a: x # default
+  b: 2 # default
+lll:
+  linelength: 120 # default
+text: foo # default
+
+# Enabled linters (2):
+#   errcheck: default
+#   unused: preset unused
+`
+
+	assert.Equal(t, expected, buf.String())
+}
+
+func Test_printedConfig_printJSON(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	err := newPrintedTestConfig(t).printJSON(buf)
+	require.NoError(t, err)
+
+	expected := `{
+  "config": {
+    "timeout": "5m0s",
+    "tests": true,
+    "limit": null,
+    "rules": [
+      {
+        "path": "_test.go",
+        "linters": [
+          "errcheck"
+        ]
+      }
+    ],
+    "settings": {
+      "a": "x",
+      "b": 2
+    },
+    "lll": {
+      "linelength": 120
+    },
+    "text": "foo"
+  },
+  "sources": {
+    "limit": "default",
+    "lll.linelength": "default",
+    "rules": ".golangci.yml:4",
+    "settings.a": "default",
+    "settings.b": "default",
+    "tests": "default",
+    "text": "default",
+    "timeout": "flag --timeout"
+  },
+  "enabled-linters": [
+    {
+      "name": "errcheck",
+      "reason": "default"
+    },
+    {
+      "name": "unused",
+      "reason": "preset",
+      "preset": "unused"
+    }
+  ]
+}
+`
+
+	assert.Equal(t, expected, buf.String())
+}
+
+func Test_printedConfig_printTOML(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	err := newPrintedTestConfig(t).printTOML(buf)
+	require.NoError(t, err)
+
+	expected := `timeout = "5m0s" # flag --timeout
+tests = true # default
+text = "foo" # default
+
+[[rules]] # .golangci.yml:4
+path = "_test.go"
+linters = ["errcheck"]
+
+[settings]
+a = "x" # default
+b = 2 # default
+
+[lll]
+linelength = 120 # default
+
+# Enabled linters (2):
+#   errcheck: default
+#   unused: preset unused
+`
+
+	assert.Equal(t, expected, buf.String())
+}
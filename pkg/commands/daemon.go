@@ -0,0 +1,267 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"syscall"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/snowmerak/golangci-lint/internal/cache"
+	"github.com/snowmerak/golangci-lint/internal/pkgcache"
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/daemon"
+	"github.com/snowmerak/golangci-lint/pkg/fsutils"
+	"github.com/snowmerak/golangci-lint/pkg/goutil"
+	"github.com/snowmerak/golangci-lint/pkg/lint"
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+	"github.com/snowmerak/golangci-lint/pkg/timeutils"
+)
+
+type daemonOptions struct {
+	config.LoaderOptions
+
+	Socket string // Flag only.
+}
+
+type daemonCommand struct {
+	viper *viper.Viper
+	cmd   *cobra.Command
+
+	opts daemonOptions
+
+	cfg *config.Config
+
+	buildInfo BuildInfo
+
+	log logutils.Log
+
+	// The state kept between the requests.
+	goenv    *goutil.Env
+	pkgCache *pkgcache.Cache
+	tracker  *lint.ChangeTracker
+
+	state *daemonState
+}
+
+// daemonState is the analysis of the previous request.
+type daemonState struct {
+	args []string
+
+	// The analyzed packages, by ID.
+	packages map[string]*packages.Package
+
+	// The issues before the output processing: the issues of the packages not affected by the changes are kept.
+	issues []result.Issue
+
+	result *daemon.Result
+}
+
+func newDaemonCommand(logger logutils.Log, info BuildInfo) *daemonCommand {
+	c := &daemonCommand{
+		viper:     viper.New(),
+		log:       logger,
+		cfg:       config.NewDefault(),
+		buildInfo: info,
+		tracker:   lint.NewChangeTracker(),
+	}
+
+	daemonCmd := &cobra.Command{
+		Use:   "daemon",
+		Short: "Run the linters on demand, keeping the caches in memory",
+		Long: `Run the linters on demand, keeping the caches in memory.
+The daemon listens on a Unix socket for the requests of "golangci-lint run --daemon" started in the same directory.
+Only the packages affected by the changes since the previous request are analyzed again.
+The configuration is read at the start of the daemon.`,
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		PreRunE:           c.preRunE,
+		RunE:              c.execute,
+		SilenceUsage:      true,
+	}
+
+	daemonCmd.SetOut(logutils.StdOut) // use custom output to properly color it in Windows terminals
+	daemonCmd.SetErr(logutils.StdErr)
+
+	fs := daemonCmd.Flags()
+	fs.SortFlags = false // sort them as they are defined here
+
+	setupConfigFileFlagSet(fs, &c.opts.LoaderOptions)
+
+	setupLintersFlagSet(c.viper, fs)
+	setupRunFlagSet(c.viper, fs)
+	setupIssuesFlagSet(c.viper, fs)
+
+	fs.StringVar(&c.opts.Socket, "socket", "",
+		color.GreenString("Path of the Unix socket (Default: a socket specific to the current directory)"))
+
+	c.cmd = daemonCmd
+
+	return c
+}
+
+func (c *daemonCommand) preRunE(cmd *cobra.Command, args []string) error {
+	c.log.Infof(c.buildInfo.String())
+
+	loader := config.NewLoader(c.log.Child(logutils.DebugKeyConfigReader), c.viper, cmd.Flags(), c.opts.LoaderOptions, c.cfg, args)
+
+	err := loader.Load(config.LoadOptions{CheckDeprecation: true, Validation: true})
+	if err != nil {
+		return fmt.Errorf("can't load config: %w", err)
+	}
+
+	if err = initHashSalt(c.buildInfo.Version, c.cfg); err != nil {
+		return fmt.Errorf("failed to init hash salt: %w", err)
+	}
+
+	c.goenv = goutil.NewEnv(c.log.Child(logutils.DebugKeyGoEnv))
+
+	if err = c.goenv.Discover(cmd.Context()); err != nil {
+		c.log.Warnf("Failed to discover go env: %s", err)
+	}
+
+	sw := timeutils.NewStopwatch("pkgcache", c.log.Child(logutils.DebugKeyStopwatch))
+
+	c.pkgCache, err = pkgcache.NewCache(sw, c.log.Child(logutils.DebugKeyPkgCache))
+	if err != nil {
+		return fmt.Errorf("failed to build packages cache: %w", err)
+	}
+
+	return nil
+}
+
+func (c *daemonCommand) execute(cmd *cobra.Command, _ []string) error {
+	workDir, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get the working directory: %w", err)
+	}
+
+	socket := c.opts.Socket
+	if socket == "" {
+		socket = daemon.DefaultSocket(workDir)
+	}
+
+	listener, err := daemon.Listen(socket)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	cmd.Printf("Listening on %s\n", socket)
+
+	return daemon.NewServer(c.log.Child(logutils.DebugKeyDaemon), workDir, c.analyze).Serve(ctx, listener)
+}
+
+// analyze runs the linters on the packages matching the arguments.
+// After the first request, only the packages affected by the changes (the packages of the changed files and their importers)
+// are loaded and analyzed again: their dependencies are loaded from the export data with the facts of the package cache,
+// and the issues of the other packages are kept.
+// All the issues are processed for the output at once.
+func (c *daemonCommand) analyze(ctx context.Context, args []string) (*daemon.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Run.Timeout)
+	defer cancel()
+
+	changes := c.tracker.Changes()
+
+	if c.state != nil && slices.Equal(args, c.state.args) && len(changes) == 0 {
+		c.log.Infof("No changes since the previous request")
+		return c.state.result, nil
+	}
+
+	// The files can have changed since the previous request.
+	cache.ForgetFileHash(changes...)
+
+	analysisArgs, fullRun, err := c.analysisArgs(args, changes)
+	if err != nil {
+		return nil, err
+	}
+
+	if !fullRun && len(analysisArgs) == 0 {
+		c.log.Infof("No packages to analyze")
+
+		// The changes of the skipped directories are not reported again.
+		c.tracker.Track(mapValues(c.state.packages), c.moduleFiles()...)
+
+		return c.state.result, nil
+	}
+
+	res, err := analyzePackages(ctx, c.log, c.cfg, c.goenv, c.pkgCache, analysisArgs, analysisOptions{deferOutput: true})
+	if err != nil {
+		return nil, err
+	}
+
+	if fullRun {
+		c.state = &daemonState{args: args, packages: map[string]*packages.Package{}}
+	} else {
+		c.log.Infof("%d changed files, %d packages analyzed", len(changes), len(res.packages))
+
+		res.issues = append(issuesOutsideDirs(c.state.issues, analysisArgs), res.issues...)
+	}
+
+	for _, pkg := range res.packages {
+		c.state.packages[pkg.ID] = pkg
+	}
+
+	c.tracker.Track(mapValues(c.state.packages), c.moduleFiles()...)
+
+	// The output processing modifies the issues.
+	c.state.issues = slices.Clone(res.issues)
+
+	fileCache := fsutils.NewFileCache()
+
+	err = processMergedIssues(c.log, c.cfg, fsutils.NewLineCache(fileCache), fileCache, res)
+	if err != nil {
+		return nil, err
+	}
+
+	c.state.result = &daemon.Result{Issues: res.issues, Report: *res.reportData}
+
+	return c.state.result, nil
+}
+
+// analysisArgs returns the directories of the packages affected by the changes since the previous request,
+// or the arguments of the request if all the packages must be analyzed.
+// It reports whether all the packages are analyzed.
+func (c *daemonCommand) analysisArgs(args, changes []string) ([]string, bool, error) {
+	// The exclusions which matched no issue can only be known by analyzing all the packages.
+	if c.state == nil || !slices.Equal(args, c.state.args) || c.cfg.Issues.ReportUnusedExcludes {
+		return args, true, nil
+	}
+
+	skipDirs, err := newSkipDirs(c.log, c.cfg, args)
+	if err != nil {
+		return nil, false, err
+	}
+
+	dirs, fullRun := affectedDirs(c.tracker, len(c.state.packages), skipDirs, changes)
+	if fullRun {
+		return args, true, nil
+	}
+
+	return dirs, false, nil
+}
+
+// moduleFiles returns the files describing the module or the workspace: a change of them affects all the packages.
+func (c *daemonCommand) moduleFiles() []string {
+	var files []string
+
+	if goMod := c.goenv.Get(goutil.EnvGoMod); goMod != "" && goMod != os.DevNull {
+		files = append(files, goMod, filepath.Join(filepath.Dir(goMod), "go.sum"))
+	}
+
+	if goWork := c.goenv.Get(goutil.EnvGoWork); goWork != "" && goWork != "off" {
+		files = append(files, goWork, goWork+".sum")
+	}
+
+	return files
+}
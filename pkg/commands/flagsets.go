@@ -17,6 +17,9 @@ import (
 
 const defaultMaxIssuesPerLinter = 50
 
+// defaultAddNolintReason is the explanation of the added nolint directives when --add-nolint has no value.
+const defaultAddNolintReason = "existing issue"
+
 func setupLintersFlagSet(v *viper.Viper, fs *pflag.FlagSet) {
 	internal.AddHackedStringSliceP(fs, "disable", "D", color.GreenString("Disable specific linter"))
 	internal.AddFlagAndBind(v, fs, fs.Bool, "disable-all", "linters.disable-all", false, color.GreenString("Disable all linters"))
@@ -53,6 +56,12 @@ func setupRunFlagSet(v *viper.Viper, fs *pflag.FlagSet) {
 
 	internal.AddFlagAndBind(v, fs, fs.Bool, "tests", "run.tests", true, color.GreenString("Analyze tests (*_test.go)"))
 
+	internal.AddFlagAndBind(v, fs, fs.Bool, "multi-module", "run.multi-module", false,
+		color.GreenString("Analyze every module of the workspace (go.work) or of the directory tree"))
+
+	internal.AddFlagAndBind(v, fs, fs.Bool, "nested-configs", "run.nested-configs", false,
+		color.GreenString("Merge the configuration files of the subdirectories onto the configuration for the packages beneath them"))
+
 	internal.AddDeprecatedHackedStringSlice(fs, "skip-files", color.GreenString("Regexps of files to skip"))
 	internal.AddDeprecatedHackedStringSlice(fs, "skip-dirs", color.GreenString("Regexps of directories to skip"))
 	internal.AddDeprecatedFlagAndBind(v, fs, fs.Bool, "skip-dirs-use-default", "run.skip-dirs-use-default", true,
@@ -69,7 +78,8 @@ func setupRunFlagSet(v *viper.Viper, fs *pflag.FlagSet) {
 
 func setupOutputFlagSet(v *viper.Viper, fs *pflag.FlagSet) {
 	internal.AddFlagAndBind(v, fs, fs.String, "out-format", "output.formats", config.OutFormatColoredLineNumber,
-		color.GreenString(fmt.Sprintf("Formats of output: %s", strings.Join(config.AllOutputFormats, "|"))))
+		color.GreenString(fmt.Sprintf("Formats of output: %s|%s:PATH",
+			strings.Join(config.AllOutputFormats, "|"), config.OutFormatTemplate)))
 	internal.AddFlagAndBind(v, fs, fs.Bool, "print-issued-lines", "output.print-issued-lines", true,
 		color.GreenString("Print lines of code with issue"))
 	internal.AddFlagAndBind(v, fs, fs.Bool, "print-linter-name", "output.print-linter-name", true,
@@ -83,6 +93,8 @@ func setupOutputFlagSet(v *viper.Viper, fs *pflag.FlagSet) {
 	internal.AddFlagAndBind(v, fs, fs.String, "path-prefix", "output.path-prefix", "",
 		color.GreenString("Path prefix to add to output"))
 	internal.AddFlagAndBind(v, fs, fs.Bool, "show-stats", "output.show-stats", false, color.GreenString("Show statistics per linter"))
+	internal.AddFlagAndBind(v, fs, fs.Bool, "print-suppressed", "output.print-suppressed", false,
+		color.GreenString("Print the issues suppressed by nolint directives and by the suppressions file (only supported by sarif)"))
 }
 
 //nolint:gomnd // magic numbers here is ok
@@ -119,8 +131,23 @@ func setupIssuesFlagSet(v *viper.Viper, fs *pflag.FlagSet) {
 		color.GreenString("Show only new issues created in git patch with file path `PATH`"))
 	internal.AddFlagAndBind(v, fs, fs.Bool, "whole-files", "issues.whole-files", false,
 		color.GreenString("Show issues in any part of update files (requires new-from-rev or new-from-patch)"))
+	internal.AddFlagAndBind(v, fs, fs.String, "baseline", "issues.baseline", "",
+		color.GreenString("Hide issues present in the baseline file with path `PATH`"))
+	internal.AddFlagAndBind(v, fs, fs.String, "baseline-write", "issues.baseline-write", "",
+		color.GreenString("Write the current issues to the baseline file with path `PATH`"))
+	internal.AddFlagAndBind(v, fs, fs.String, "suppressions", "issues.suppressions", "",
+		color.GreenString("Hide issues matching the entries of the suppressions file with path `PATH`"))
 	internal.AddFlagAndBind(v, fs, fs.Bool, "fix", "issues.fix", false,
 		color.GreenString("Fix found issues (if it's supported by the linter)"))
+	internal.AddFlagAndBind(v, fs, fs.String, "fix-mode", "issues.fix-mode", config.FixModeWrite,
+		color.GreenString(fmt.Sprintf("How the fixes are applied (requires fix or add-nolint): %s",
+			strings.Join(config.AllFixModes, "|"))))
+	internal.AddFlagAndBind(v, fs, fs.String, "add-nolint", "issues.add-nolint", "",
+		color.GreenString("Add nolint directives with the explanation `REASON` for the issues, "+
+			"at the lines, the declarations or the files"))
+	fs.Lookup("add-nolint").NoOptDefVal = defaultAddNolintReason
+	internal.AddFlagAndBind(v, fs, fs.Bool, "report-unused-excludes", "issues.report-unused-excludes", false,
+		color.GreenString("Report the exclusions which matched no issue"))
 }
 
 func getDefaultIssueExcludeHelp() string {
@@ -0,0 +1,148 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/snowmerak/golangci-lint/internal/pkgcache"
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/goutil"
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+	"github.com/snowmerak/golangci-lint/pkg/lsp"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+	"github.com/snowmerak/golangci-lint/pkg/timeutils"
+)
+
+type lspOptions struct {
+	config.LoaderOptions
+}
+
+type lspCommand struct {
+	viper *viper.Viper
+	cmd   *cobra.Command
+
+	opts lspOptions
+
+	cfg *config.Config
+
+	buildInfo BuildInfo
+
+	log logutils.Log
+
+	// The state kept between the analyses.
+	goenv    *goutil.Env
+	pkgCache *pkgcache.Cache
+}
+
+func newLSPCommand(logger logutils.Log, info BuildInfo) *lspCommand {
+	c := &lspCommand{
+		viper:     viper.New(),
+		log:       logger,
+		cfg:       config.NewDefault(),
+		buildInfo: info,
+	}
+
+	lspCmd := &cobra.Command{
+		Use:   "lsp",
+		Short: "Run a language server publishing the issues as diagnostics",
+		Long: `Run a language server publishing the issues as diagnostics.
+The server speaks the Language Server Protocol over stdio, it must be started in the root directory of the project.
+The configuration is read at the start of the server.`,
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		PreRunE:           c.preRunE,
+		RunE:              c.execute,
+		SilenceUsage:      true,
+	}
+
+	lspCmd.SetErr(logutils.StdErr)
+
+	fs := lspCmd.Flags()
+	fs.SortFlags = false // sort them as they are defined here
+
+	setupConfigFileFlagSet(fs, &c.opts.LoaderOptions)
+
+	setupLintersFlagSet(c.viper, fs)
+	setupRunFlagSet(c.viper, fs)
+	setupIssuesFlagSet(c.viper, fs)
+
+	c.cmd = lspCmd
+
+	return c
+}
+
+func (c *lspCommand) preRunE(cmd *cobra.Command, args []string) error {
+	c.log.Infof(c.buildInfo.String())
+
+	loader := config.NewLoader(c.log.Child(logutils.DebugKeyConfigReader), c.viper, cmd.Flags(), c.opts.LoaderOptions, c.cfg, args)
+
+	err := loader.Load(config.LoadOptions{CheckDeprecation: true, Validation: true})
+	if err != nil {
+		return fmt.Errorf("can't load config: %w", err)
+	}
+
+	// The fixes are proposed as code actions: the files are only modified by the client.
+	c.cfg.Issues.NeedFix = false
+
+	// The paths of the issues are resolved from the working directory.
+	c.cfg.Output.PathPrefix = ""
+
+	if err = initHashSalt(c.buildInfo.Version, c.cfg); err != nil {
+		return fmt.Errorf("failed to init hash salt: %w", err)
+	}
+
+	c.goenv = goutil.NewEnv(c.log.Child(logutils.DebugKeyGoEnv))
+
+	if err = c.goenv.Discover(cmd.Context()); err != nil {
+		c.log.Warnf("Failed to discover go env: %s", err)
+	}
+
+	sw := timeutils.NewStopwatch("pkgcache", c.log.Child(logutils.DebugKeyStopwatch))
+
+	c.pkgCache, err = pkgcache.NewCache(sw, c.log.Child(logutils.DebugKeyPkgCache))
+	if err != nil {
+		return fmt.Errorf("failed to build packages cache: %w", err)
+	}
+
+	return nil
+}
+
+func (c *lspCommand) execute(_ *cobra.Command, _ []string) error {
+	workDir, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get the working directory: %w", err)
+	}
+
+	// The standard output is reserved to the protocol:
+	// the linters and the loader must not print anything there.
+	stdout := os.Stdout
+	os.Stdout = os.Stderr
+	log.SetOutput(io.Discard)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	server := lsp.NewServer(c.log.Child(logutils.DebugKeyLSP), workDir, c.buildInfo.Version, c.analyze)
+
+	return server.Serve(ctx, os.Stdin, stdout)
+}
+
+func (c *lspCommand) analyze(ctx context.Context, args []string, overlay map[string][]byte) ([]result.Issue, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Run.Timeout)
+	defer cancel()
+
+	res, err := analyzePackages(ctx, c.log, c.cfg, c.goenv, c.pkgCache, args, analysisOptions{overlay: overlay})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.issues, nil
+}
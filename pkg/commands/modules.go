@@ -0,0 +1,207 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/snowmerak/golangci-lint/internal/pkgcache"
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/exitcodes"
+	"github.com/snowmerak/golangci-lint/pkg/fsutils"
+	"github.com/snowmerak/golangci-lint/pkg/goutil"
+	"github.com/snowmerak/golangci-lint/pkg/lint"
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+	"github.com/snowmerak/golangci-lint/pkg/report"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+	"github.com/snowmerak/golangci-lint/pkg/result/processors"
+	"github.com/snowmerak/golangci-lint/pkg/timeutils"
+)
+
+// runModulesAnalysis executes the linters on each module of the workspace or of the directory trees of the arguments.
+// Each module is loaded from its root directory, with its nested configuration files.
+func (c *runCommand) runModulesAnalysis(ctx context.Context, args []string) ([]result.Issue, error) {
+	roots, err := moduleRoots(args)
+	if err != nil {
+		return nil, err
+	}
+
+	skipDirs, err := newSkipDirs(c.log, c.cfg, args)
+	if err != nil {
+		return nil, err
+	}
+
+	modules, err := lint.FindModules(c.goenv.Get(goutil.EnvGoWork), roots, func(dir string) bool {
+		return skipDirs.SkipsDir(relPath(dir))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(modules) == 0 {
+		return nil, fmt.Errorf("no Go modules in %s: %w", strings.Join(args, " "), exitcodes.ErrNoGoFiles)
+	}
+
+	c.log.Infof("Found %d modules", len(modules))
+
+	sw := timeutils.NewStopwatch("pkgcache", c.log.Child(logutils.DebugKeyStopwatch))
+
+	pkgCache, err := pkgcache.NewCache(sw, c.log.Child(logutils.DebugKeyPkgCache))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build packages cache: %w", err)
+	}
+
+	merged := &analysisResult{reportData: &report.Data{}}
+
+	for _, module := range modules {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		res, status := c.analyzeModule(ctx, pkgCache, module)
+
+		c.reportData.Modules = append(c.reportData.Modules, status)
+
+		if res != nil {
+			mergeAnalysisResult(merged, res)
+		}
+	}
+
+	// The limits of the configuration of the run apply to the issues of all the modules.
+	err = processMergedIssues(c.log, c.cfg, c.lineCache, c.fileCache, merged)
+	if err != nil {
+		return nil, err
+	}
+
+	mergeReportData(c.reportData, merged.reportData)
+
+	return merged.issues, nil
+}
+
+// analyzeModule executes the linters on the packages of a module.
+// A failure of the analysis is reported by the status of the module, without result.
+func (c *runCommand) analyzeModule(ctx context.Context, pkgCache *pkgcache.Cache, module lint.Module) (*analysisResult, report.ModuleData) {
+	status := report.ModuleData{
+		Path: module.Path,
+		Dir:  relPath(module.Dir),
+	}
+
+	if configFile := c.moduleConfigFile(module); configFile != "" {
+		status.Config = relPath(configFile)
+	}
+
+	res, err := c.analyzeModulePackages(ctx, pkgCache, module)
+	if err != nil {
+		status.Error = err.Error()
+		c.log.Errorf("Module %s: %s", module.Path, err)
+
+		return nil, status
+	}
+
+	status.Packages = len(res.packages)
+
+	// The issues are not processed for the output yet: the suppressed issues are still included.
+	active, _ := processors.SplitSuppressed(res.issues)
+	status.Issues = len(active)
+
+	c.log.Infof("Module %s (%s): %d packages, %d issues", module.Path, status.Dir, status.Packages, status.Issues)
+
+	return res, status
+}
+
+// analyzeModulePackages executes the linters on the packages of the module,
+// with the configuration files of the module merged onto the configuration of the run.
+func (c *runCommand) analyzeModulePackages(ctx context.Context, pkgCache *pkgcache.Cache, module lint.Module) (*analysisResult, error) {
+	args := []string{filepath.Join(module.Dir, "...")}
+
+	scopes, err := c.findConfigScopes(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if scopes != nil {
+		return c.runScopesAnalysis(ctx, pkgCache, scopes, module.Dir)
+	}
+
+	return analyzePackages(ctx, c.log, c.cfg, c.goenv, pkgCache, args, analysisOptions{dir: module.Dir, deferOutput: true})
+}
+
+// moduleConfigFile returns the nearest configuration file of the module, or an empty string.
+func (c *runCommand) moduleConfigFile(module lint.Module) string {
+	if c.opts.Config != "" || c.opts.NoConfig {
+		return c.rootConfigFile()
+	}
+
+	workDir, err := fsutils.Getwd()
+	if err != nil {
+		return c.rootConfigFile()
+	}
+
+	if configFile := findConfigFile(module.Dir, workDir); configFile != "" {
+		return configFile
+	}
+
+	return c.rootConfigFile()
+}
+
+func (c *runCommand) printModulesStats() {
+	if len(c.reportData.Modules) == 0 {
+		return
+	}
+
+	c.cmd.Printf("%d modules:\n", len(c.reportData.Modules))
+
+	for _, module := range c.reportData.Modules {
+		if module.Error != "" {
+			c.cmd.Printf("* %s (%s): failed: %s\n", module.Path, module.Dir, module.Error)
+			continue
+		}
+
+		c.cmd.Printf("* %s (%s): %d packages, %d issues\n", module.Path, module.Dir, module.Packages, module.Issues)
+	}
+}
+
+// findConfigFile returns the first configuration file found from the directory up to the root directory (included).
+func findConfigFile(dir, root string) string {
+	for {
+		for _, name := range config.FileNames {
+			path := filepath.Join(dir, name)
+			if _, err := os.Stat(path); err == nil {
+				return path
+			}
+		}
+
+		parent := filepath.Dir(dir)
+		if dir == root || parent == dir || !isSubDir(root, parent) {
+			return ""
+		}
+
+		dir = parent
+	}
+}
+
+// moduleRoots returns the absolute directories of the arguments: the modules are searched in these directories.
+func moduleRoots(args []string) ([]string, error) {
+	if len(args) == 0 {
+		args = []string{"."}
+	}
+
+	var roots []string
+	for _, arg := range args {
+		arg = strings.TrimSuffix(filepath.ToSlash(arg), "/...")
+		if arg == "..." {
+			arg = "."
+		}
+
+		root, err := filepath.Abs(filepath.FromSlash(arg))
+		if err != nil {
+			return nil, err
+		}
+
+		roots = append(roots, root)
+	}
+
+	return roots, nil
+}
@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_findConfigFile(t *testing.T) {
+	root := t.TempDir()
+
+	moduleDir := filepath.Join(root, "a", "b")
+	require.NoError(t, os.MkdirAll(moduleDir, 0o755))
+
+	assert.Empty(t, findConfigFile(moduleDir, root))
+
+	rootConfig := filepath.Join(root, ".golangci.yml")
+	require.NoError(t, os.WriteFile(rootConfig, nil, 0o600))
+
+	assert.Equal(t, rootConfig, findConfigFile(moduleDir, root))
+
+	parentConfig := filepath.Join(root, "a", ".golangci.toml")
+	require.NoError(t, os.WriteFile(parentConfig, nil, 0o600))
+
+	assert.Equal(t, parentConfig, findConfigFile(moduleDir, root))
+
+	// The search stops at the root directory.
+	assert.Empty(t, findConfigFile(moduleDir, filepath.Join(root, "a", "b")))
+}
+
+func Test_moduleRoots(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+
+	roots, err := moduleRoots(nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{wd}, roots)
+
+	roots, err = moduleRoots([]string{"./...", "foo/...", "bar"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{wd, filepath.Join(wd, "foo"), filepath.Join(wd, "bar")}, roots)
+}
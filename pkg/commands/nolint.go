@@ -0,0 +1,311 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/snowmerak/golangci-lint/internal/pkgcache"
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/goutil"
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+	"github.com/snowmerak/golangci-lint/pkg/result/processors"
+	"github.com/snowmerak/golangci-lint/pkg/timeutils"
+)
+
+// Formats of the listed nolint directives.
+const (
+	nolintFormatText = "text"
+	nolintFormatJSON = "json"
+)
+
+var allNolintFormats = []string{nolintFormatText, nolintFormatJSON}
+
+// nolintAllLinters is the name used in the aggregates for the directives without linters.
+const nolintAllLinters = "all"
+
+type nolintOptions struct {
+	config.LoaderOptions
+
+	format string // Flag only.
+}
+
+type nolintCommand struct {
+	viper *viper.Viper
+	cmd   *cobra.Command
+
+	opts nolintOptions
+
+	cfg *config.Config
+
+	buildInfo BuildInfo
+
+	log logutils.Log
+}
+
+func newNolintCommand(logger logutils.Log, info BuildInfo) *nolintCommand {
+	c := &nolintCommand{
+		viper:     viper.New(),
+		log:       logger,
+		cfg:       config.NewDefault(),
+		buildInfo: info,
+	}
+
+	nolintCmd := &cobra.Command{
+		Use:   "nolint",
+		Short: "Nolint directives information",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return cmd.Help()
+		},
+	}
+
+	listCommand := &cobra.Command{
+		Use:   "list",
+		Short: "List the nolint directives, with the issues they suppressed",
+		Long: `List the nolint directives of the analyzed packages, with the lines they cover.
+The linters are run to know which directives suppressed issues: a directive which suppressed nothing is unused.
+The directives are aggregated by linter and by directory.`,
+		PreRunE:      c.preRunE,
+		RunE:         c.executeList,
+		SilenceUsage: true,
+	}
+
+	listCommand.SetOut(logutils.StdOut) // use custom output to properly color it in Windows terminals
+	listCommand.SetErr(logutils.StdErr)
+
+	fs := listCommand.Flags()
+	fs.SortFlags = false // sort them as they are defined here
+
+	fs.StringVar(&c.opts.format, "format", nolintFormatText,
+		color.GreenString(fmt.Sprintf("Format of the list: %s", strings.Join(allNolintFormats, "|"))))
+
+	setupConfigFileFlagSet(fs, &c.opts.LoaderOptions)
+
+	setupLintersFlagSet(c.viper, fs)
+	setupRunFlagSet(c.viper, fs)
+	setupIssuesFlagSet(c.viper, fs)
+
+	nolintCmd.AddCommand(listCommand)
+
+	c.cmd = nolintCmd
+
+	return c
+}
+
+func (c *nolintCommand) preRunE(cmd *cobra.Command, args []string) error {
+	if !slices.Contains(allNolintFormats, c.opts.format) {
+		return fmt.Errorf("unknown format %q: only %s are allowed", c.opts.format, strings.Join(allNolintFormats, ", "))
+	}
+
+	c.log.Infof(c.buildInfo.String())
+
+	loader := config.NewLoader(c.log.Child(logutils.DebugKeyConfigReader), c.viper, cmd.Flags(), c.opts.LoaderOptions, c.cfg, args)
+
+	err := loader.Load(config.LoadOptions{CheckDeprecation: true, Validation: true})
+	if err != nil {
+		return fmt.Errorf("can't load config: %w", err)
+	}
+
+	if err = initHashSalt(c.buildInfo.Version, c.cfg); err != nil {
+		return fmt.Errorf("failed to init hash salt: %w", err)
+	}
+
+	return nil
+}
+
+func (c *nolintCommand) executeList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if c.cfg.Run.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.Run.Timeout)
+		defer cancel()
+	}
+
+	goenv := goutil.NewEnv(c.log.Child(logutils.DebugKeyGoEnv))
+
+	if err := goenv.Discover(ctx); err != nil {
+		c.log.Warnf("Failed to discover go env: %s", err)
+	}
+
+	sw := timeutils.NewStopwatch("pkgcache", c.log.Child(logutils.DebugKeyStopwatch))
+
+	pkgCache, err := pkgcache.NewCache(sw, c.log.Child(logutils.DebugKeyPkgCache))
+	if err != nil {
+		return fmt.Errorf("failed to build packages cache: %w", err)
+	}
+
+	res, err := analyzePackages(ctx, c.log, c.cfg, goenv, pkgCache, args, analysisOptions{nolintDirectives: true})
+	if err != nil {
+		return err
+	}
+
+	list := newNolintList(res.directives)
+
+	if c.opts.format == nolintFormatJSON {
+		return list.printJSON(cmd.OutOrStdout())
+	}
+
+	return list.printText(cmd.OutOrStdout())
+}
+
+// nolintList is the list of the nolint directives with their aggregates.
+type nolintList struct {
+	Directives  []listedDirective `json:"directives"`
+	Linters     []nolintAggregate `json:"by-linter"`
+	Directories []nolintAggregate `json:"by-directory"`
+}
+
+type listedDirective struct {
+	File    string   `json:"file"`
+	Line    int      `json:"line"`
+	Column  int      `json:"column"`
+	Linters []string `json:"linters"`
+	Reason  string   `json:"reason,omitempty"`
+	// The lines covered by the directive.
+	From int `json:"from"`
+	To   int `json:"to"`
+	// The linters of the issues suppressed by the directive.
+	Suppressed []string `json:"suppressed"`
+	Used       bool     `json:"used"`
+}
+
+// nolintAggregate counts the directives of a linter or of a directory.
+type nolintAggregate struct {
+	Name       string `json:"name"`
+	Directives int    `json:"directives"`
+	Unused     int    `json:"unused"`
+}
+
+func newNolintList(directives []processors.NolintDirective) *nolintList {
+	list := &nolintList{Directives: []listedDirective{}}
+
+	byLinter := map[string]*nolintAggregate{}
+	byDirectory := map[string]*nolintAggregate{}
+
+	count := func(aggregates map[string]*nolintAggregate, name string, used bool) {
+		aggregate, ok := aggregates[name]
+		if !ok {
+			aggregate = &nolintAggregate{Name: name}
+			aggregates[name] = aggregate
+		}
+
+		aggregate.Directives++
+
+		if !used {
+			aggregate.Unused++
+		}
+	}
+
+	for _, directive := range directives {
+		listed := listedDirective{
+			File:       filepath.ToSlash(directive.File),
+			Line:       directive.Line,
+			Column:     directive.Column,
+			Linters:    directive.Linters,
+			Reason:     directive.Reason,
+			From:       directive.Range.From,
+			To:         directive.Range.To,
+			Suppressed: directive.Suppressed,
+			Used:       len(directive.Suppressed) > 0,
+		}
+
+		if listed.Linters == nil {
+			listed.Linters = []string{}
+		}
+
+		if listed.Suppressed == nil {
+			listed.Suppressed = []string{}
+		}
+
+		list.Directives = append(list.Directives, listed)
+
+		if len(directive.Linters) == 0 {
+			count(byLinter, nolintAllLinters, listed.Used)
+		}
+
+		for _, name := range directive.Linters {
+			count(byLinter, name, listed.Used)
+		}
+
+		count(byDirectory, path.Dir(listed.File), listed.Used)
+	}
+
+	list.Linters = sortedAggregates(byLinter)
+	list.Directories = sortedAggregates(byDirectory)
+
+	return list
+}
+
+func (l *nolintList) printJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if err := encoder.Encode(l); err != nil {
+		return fmt.Errorf("failed to print the nolint directives: %w", err)
+	}
+
+	return nil
+}
+
+func (l *nolintList) printText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "POSITION\tLINTERS\tLINES\tSUPPRESSED\tREASON")
+
+	for _, d := range l.Directives {
+		linters := strings.Join(d.Linters, ",")
+		if linters == "" {
+			linters = nolintAllLinters
+		}
+
+		suppressed := strings.Join(d.Suppressed, ",")
+		if suppressed == "" {
+			suppressed = "-"
+		}
+
+		fmt.Fprintf(tw, "%s:%d:%d\t%s\t%d-%d\t%s\t%s\n", d.File, d.Line, d.Column, linters, d.From, d.To, suppressed, d.Reason)
+	}
+
+	printAggregates(tw, "LINTER", l.Linters)
+	printAggregates(tw, "DIRECTORY", l.Directories)
+
+	return tw.Flush()
+}
+
+func printAggregates(w io.Writer, title string, aggregates []nolintAggregate) {
+	fmt.Fprintf(w, "\n%s\tDIRECTIVES\tUNUSED\n", title)
+
+	for _, aggregate := range aggregates {
+		fmt.Fprintf(w, "%s\t%d\t%d\n", aggregate.Name, aggregate.Directives, aggregate.Unused)
+	}
+}
+
+func sortedAggregates(aggregates map[string]*nolintAggregate) []nolintAggregate {
+	sorted := make([]nolintAggregate, 0, len(aggregates))
+
+	for _, aggregate := range aggregates {
+		sorted = append(sorted, *aggregate)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	return sorted
+}
@@ -0,0 +1,91 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/snowmerak/golangci-lint/pkg/result"
+	"github.com/snowmerak/golangci-lint/pkg/result/processors"
+)
+
+func Test_newNolintList(t *testing.T) {
+	directives := []processors.NolintDirective{
+		{
+			File:       "a.go",
+			Line:       3,
+			Column:     1,
+			Linters:    []string{"errcheck", "gosec"},
+			Reason:     "legacy",
+			Range:      result.Range{From: 3, To: 8},
+			Suppressed: []string{"errcheck"},
+		},
+		{
+			File:   "pkg/b.go",
+			Line:   5,
+			Column: 10,
+			Range:  result.Range{From: 5, To: 5},
+		},
+		{
+			File:    "pkg/c.go",
+			Line:    7,
+			Column:  2,
+			Linters: []string{"gosec"},
+			Range:   result.Range{From: 7, To: 7},
+		},
+	}
+
+	list := newNolintList(directives)
+
+	require.Len(t, list.Directives, 3)
+	assert.True(t, list.Directives[0].Used)
+	assert.False(t, list.Directives[1].Used)
+	assert.Equal(t, []string{}, list.Directives[1].Linters)
+
+	expectedLinters := []nolintAggregate{
+		{Name: "all", Directives: 1, Unused: 1},
+		{Name: "errcheck", Directives: 1, Unused: 0},
+		{Name: "gosec", Directives: 2, Unused: 1},
+	}
+
+	assert.Equal(t, expectedLinters, list.Linters)
+
+	expectedDirectories := []nolintAggregate{
+		{Name: ".", Directives: 1, Unused: 0},
+		{Name: "pkg", Directives: 2, Unused: 2},
+	}
+
+	assert.Equal(t, expectedDirectories, list.Directories)
+}
+
+func Test_nolintList_printText(t *testing.T) {
+	list := newNolintList([]processors.NolintDirective{
+		{
+			File:       "a.go",
+			Line:       3,
+			Column:     1,
+			Linters:    []string{"errcheck"},
+			Reason:     "legacy",
+			Range:      result.Range{From: 3, To: 8},
+			Suppressed: []string{"errcheck"},
+		},
+	})
+
+	buf := new(bytes.Buffer)
+
+	require.NoError(t, list.printText(buf))
+
+	expected := `POSITION  LINTERS   LINES  SUPPRESSED  REASON
+a.go:3:1  errcheck  3-8    errcheck    legacy
+
+LINTER    DIRECTIVES  UNUSED
+errcheck  1           0
+
+DIRECTORY  DIRECTIVES  UNUSED
+.          1           0
+`
+
+	assert.Equal(t, expected, buf.String())
+}
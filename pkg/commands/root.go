@@ -60,8 +60,11 @@ func newRootCommand(info BuildInfo) *rootCommand {
 	rootCmd.AddCommand(
 		newLintersCommand(log).cmd,
 		newRunCommand(log, info).cmd,
+		newDaemonCommand(log, info).cmd,
+		newLSPCommand(log, info).cmd,
 		newCacheCommand().cmd,
 		newConfigCommand(log, info).cmd,
+		newNolintCommand(log, info).cmd,
 		newVersionCommand(info).cmd,
 		newCustomCommand(log).cmd,
 	)
@@ -13,6 +13,7 @@ import (
 	"runtime"
 	"runtime/pprof"
 	"runtime/trace"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -30,6 +31,7 @@ import (
 	"github.com/snowmerak/golangci-lint/internal/cache"
 	"github.com/snowmerak/golangci-lint/internal/pkgcache"
 	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/daemon"
 	"github.com/snowmerak/golangci-lint/pkg/exitcodes"
 	"github.com/snowmerak/golangci-lint/pkg/fsutils"
 	"github.com/snowmerak/golangci-lint/pkg/goanalysis/load"
@@ -41,6 +43,7 @@ import (
 	"github.com/snowmerak/golangci-lint/pkg/printers"
 	"github.com/snowmerak/golangci-lint/pkg/report"
 	"github.com/snowmerak/golangci-lint/pkg/result"
+	"github.com/snowmerak/golangci-lint/pkg/result/processors"
 	"github.com/snowmerak/golangci-lint/pkg/timeutils"
 )
 
@@ -67,6 +70,11 @@ type runOptions struct {
 	TracePath      string // Flag only.
 
 	PrintResourcesUsage bool // Flag only.
+
+	UseDaemon    bool   // Flag only.
+	DaemonSocket string // Flag only.
+
+	Watch bool // Flag only.
 }
 
 type runCommand struct {
@@ -95,6 +103,9 @@ type runCommand struct {
 
 	flock *flock.Flock
 
+	// The configuration file of the run: the viper instance can read other configuration files (ex: nested configuration files).
+	rootConfig string
+
 	exitCode int
 }
 
@@ -139,6 +150,8 @@ func newRunCommand(logger logutils.Log, info BuildInfo) *runCommand {
 	setupRunFlagSet(c.viper, fs)
 	setupOutputFlagSet(c.viper, fs)
 	setupIssuesFlagSet(c.viper, fs)
+	setupDaemonClientFlagSet(fs, &c.opts)
+	setupWatchFlagSet(fs, &c.opts)
 
 	setupRunPersistentFlags(runCmd.PersistentFlags(), &c.opts)
 
@@ -161,6 +174,8 @@ func (c *runCommand) persistentPreRunE(cmd *cobra.Command, args []string) error
 		return fmt.Errorf("can't load config: %w", err)
 	}
 
+	c.rootConfig = c.viper.ConfigFileUsed()
+
 	if c.cfg.Run.Concurrency == 0 {
 		backup := runtime.GOMAXPROCS(0)
 
@@ -236,6 +251,11 @@ func (c *runCommand) postRun(_ *cobra.Command, _ []string) {
 }
 
 func (c *runCommand) execute(_ *cobra.Command, args []string) {
+	if c.opts.Watch {
+		c.executeWatch(args)
+		return
+	}
+
 	needTrackResources := logutils.IsVerbose() || c.opts.PrintResourcesUsage
 
 	trackResourcesEndCh := make(chan struct{})
@@ -346,7 +366,19 @@ func (c *runCommand) runAndPrint(ctx context.Context, args []string) error {
 
 	c.printDeprecatedLinterMessages(enabledLintersMap)
 
-	issues, err := c.runAnalysis(ctx, args)
+	var issues []result.Issue
+	switch {
+	case c.opts.UseDaemon || c.opts.DaemonSocket != "":
+		if c.cfg.Run.MultiModule {
+			return errors.New("the options --daemon and --multi-module can't be combined")
+		}
+
+		issues, err = c.runAnalysisWithDaemon(ctx, args)
+	case c.cfg.Run.MultiModule:
+		issues, err = c.runModulesAnalysis(ctx, args)
+	default:
+		issues, err = c.runAnalysis(ctx, args)
+	}
 	if err != nil {
 		return err // XXX: don't lose type
 	}
@@ -354,9 +386,12 @@ func (c *runCommand) runAndPrint(ctx context.Context, args []string) error {
 	// Fills linters information for the JSON printer.
 	for _, lc := range c.dbManager.GetAllSupportedLinterConfigs() {
 		isEnabled := enabledLintersMap[lc.Name()] != nil
-		c.reportData.AddLinter(lc.Name(), isEnabled, lc.EnabledByDefault)
+		c.reportData.AddLinter(lc.Name(), isEnabled, lc.EnabledByDefault, lc.Linter.Desc(), lc.OriginalURL, lc.InPresets)
 	}
 
+	// The exit code is reported by some printers (ex: SARIF).
+	c.reportData.ExitCode = c.getExitCodeIfIssuesFound(issues)
+
 	err = c.printer.Print(issues)
 	if err != nil {
 		return err
@@ -373,6 +408,15 @@ func (c *runCommand) runAndPrint(ctx context.Context, args []string) error {
 
 // runAnalysis executes the linters that have been enabled in the configuration.
 func (c *runCommand) runAnalysis(ctx context.Context, args []string) ([]result.Issue, error) {
+	scopes, err := c.findConfigScopes(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if scopes != nil {
+		return c.runNestedConfigsAnalysis(ctx, scopes)
+	}
+
 	lintersToRun, err := c.dbManager.GetOptimizedLinters()
 	if err != nil {
 		return nil, err
@@ -389,7 +433,46 @@ func (c *runCommand) runAnalysis(ctx context.Context, args []string) ([]result.I
 		return nil, err
 	}
 
-	return runner.Run(ctx, lintersToRun)
+	issues, err := runner.Run(ctx, lintersToRun)
+
+	c.reportData.Suppressed = runner.SuppressedIssues()
+
+	return issues, err
+}
+
+// runAnalysisWithDaemon sends the analysis to the daemon started in the working directory.
+// The issues are processed with the configuration of the daemon, the output is configured by the client.
+func (c *runCommand) runAnalysisWithDaemon(ctx context.Context, args []string) ([]result.Issue, error) {
+	workDir, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the working directory: %w", err)
+	}
+
+	socket := c.opts.DaemonSocket
+	if socket == "" {
+		socket = daemon.DefaultSocket(workDir)
+	}
+
+	res, err := daemon.Run(ctx, socket, daemon.Request{WorkDir: workDir, Args: args})
+	if err != nil {
+		return nil, err
+	}
+
+	c.reportData.Warnings = append(c.reportData.Warnings, res.Report.Warnings...)
+	c.reportData.Error = res.Report.Error
+	c.reportData.Suppressed = res.Report.Suppressed
+
+	return res.Issues, nil
+}
+
+// newSkipDirs creates the matcher of the directories excluded by the configuration.
+func newSkipDirs(log logutils.Log, cfg *config.Config, args []string) (*processors.SkipDirs, error) {
+	patterns := slices.Clone(cfg.Issues.ExcludeDirs)
+	if cfg.Issues.UseDefaultExcludeDirs {
+		patterns = append(patterns, processors.StdExcludeDirRegexps...)
+	}
+
+	return processors.NewSkipDirs(log.Child(logutils.DebugKeySkipDirs), patterns, args, cfg.Output.PathPrefix)
 }
 
 func (c *runCommand) setOutputToDevNull() (savedStdout, savedStderr *os.File) {
@@ -405,9 +488,15 @@ func (c *runCommand) setOutputToDevNull() (savedStdout, savedStderr *os.File) {
 }
 
 func (c *runCommand) setExitCodeIfIssuesFound(issues []result.Issue) {
+	c.exitCode = c.getExitCodeIfIssuesFound(issues)
+}
+
+func (c *runCommand) getExitCodeIfIssuesFound(issues []result.Issue) int {
 	if len(issues) != 0 {
-		c.exitCode = c.cfg.Run.ExitCodeIfIssuesFound
+		return c.cfg.Run.ExitCodeIfIssuesFound
 	}
+
+	return c.exitCode
 }
 
 func (c *runCommand) printDeprecatedLinterMessages(enabledLinters map[string]*linter.Config) {
@@ -434,6 +523,8 @@ func (c *runCommand) printStats(issues []result.Issue) {
 		return
 	}
 
+	c.printModulesStats()
+
 	if len(issues) == 0 {
 		c.cmd.Println("0 issues.")
 		return
@@ -587,6 +678,18 @@ func setupRunPersistentFlags(fs *pflag.FlagSet, opts *runOptions) {
 	fs.StringVar(&opts.TracePath, "trace-path", "", color.GreenString("Path to trace output file"))
 }
 
+func setupDaemonClientFlagSet(fs *pflag.FlagSet, opts *runOptions) {
+	fs.BoolVar(&opts.UseDaemon, "daemon", false,
+		color.GreenString("Send the request to the daemon started with 'golangci-lint daemon' in the current directory"))
+	fs.StringVar(&opts.DaemonSocket, "daemon-socket", "",
+		color.GreenString("Path of the Unix socket of the daemon (implies --daemon)"))
+}
+
+func setupWatchFlagSet(fs *pflag.FlagSet, opts *runOptions) {
+	fs.BoolVar(&opts.Watch, "watch", false,
+		color.GreenString("Watch the files and analyze the affected packages on each change, until interrupted"))
+}
+
 func getDefaultConcurrency() int {
 	if os.Getenv(envHelpRun) == "1" {
 		// Make stable concurrency for generating help documentation.
@@ -0,0 +1,363 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/snowmerak/golangci-lint/internal/pkgcache"
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/fsutils"
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+	"github.com/snowmerak/golangci-lint/pkg/report"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+	"github.com/snowmerak/golangci-lint/pkg/timeutils"
+)
+
+// configScope is a set of packages sharing the same nested configuration files.
+type configScope struct {
+	// The nested configuration files, from the outermost directory to the innermost directory.
+	files []string
+
+	// The arguments of the analysis: the directories of the packages, and the files.
+	args []string
+}
+
+// scopesFinder groups the packages by nested configuration files.
+// A nested configuration file is a configuration file inside a directory below the working directory:
+// it is merged onto the configuration of its parent directories for the packages beneath it.
+type scopesFinder struct {
+	workDir    string
+	rootConfig string
+
+	skipDir func(dir string) bool
+
+	// The configuration file of a directory, or an empty string.
+	configFiles map[string]string
+}
+
+func newScopesFinder(workDir, rootConfig string, skipDir func(dir string) bool) *scopesFinder {
+	return &scopesFinder{
+		workDir:     workDir,
+		rootConfig:  rootConfig,
+		skipDir:     skipDir,
+		configFiles: map[string]string{},
+	}
+}
+
+// find returns the scopes of the packages matching the arguments, or nil if there is no nested configuration file.
+func (f *scopesFinder) find(args []string) ([]configScope, error) {
+	if len(args) == 0 {
+		args = []string{"./..."}
+	}
+
+	scopes := map[string]*configScope{}
+
+	var keys []string
+
+	add := func(dir, arg string) {
+		files := f.nestedFiles(dir)
+		key := strings.Join(files, string(os.PathListSeparator))
+
+		scope, ok := scopes[key]
+		if !ok {
+			scope = &configScope{files: files}
+			scopes[key] = scope
+			keys = append(keys, key)
+		}
+
+		scope.args = append(scope.args, arg)
+	}
+
+	for _, arg := range args {
+		root, recursive := strings.CutSuffix(filepath.ToSlash(arg), "/...")
+		if root == "..." {
+			root, recursive = ".", true
+		}
+
+		root, err := filepath.Abs(filepath.FromSlash(root))
+		if err != nil {
+			return nil, err
+		}
+
+		if !recursive {
+			dir := root
+			if !fsutils.IsDir(root) {
+				dir = filepath.Dir(root)
+			}
+
+			add(dir, root)
+
+			continue
+		}
+
+		dirs, err := f.packageDirs(root)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, dir := range dirs {
+			add(dir, dir)
+		}
+	}
+
+	if len(keys) == 0 || (len(keys) == 1 && keys[0] == "") {
+		return nil, nil
+	}
+
+	slices.Sort(keys)
+
+	result := make([]configScope, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, *scopes[key])
+	}
+
+	return result, nil
+}
+
+// packageDirs returns the directories containing Go files in the directory tree, like the pattern `dir/...`.
+func (f *scopesFinder) packageDirs(root string) ([]string, error) {
+	var dirs []string
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.IsDir() {
+			return nil
+		}
+
+		if path != root {
+			name := d.Name()
+			if strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") || name == "testdata" || name == "vendor" {
+				return filepath.SkipDir
+			}
+
+			// The nested modules are not matched by the pattern.
+			if _, err := os.Stat(filepath.Join(path, "go.mod")); err == nil {
+				return filepath.SkipDir
+			}
+		}
+
+		if f.skipDir(path) {
+			return filepath.SkipDir
+		}
+
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			if !entry.IsDir() && filepath.Ext(entry.Name()) == ".go" {
+				dirs = append(dirs, path)
+				break
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dirs, nil
+}
+
+// nestedFiles returns the nested configuration files applied to the packages of the directory.
+func (f *scopesFinder) nestedFiles(dir string) []string {
+	var files []string
+
+	for isSubDir(f.workDir, dir) && dir != f.workDir {
+		if file := f.configFile(dir); file != "" && file != f.rootConfig {
+			files = append(files, file)
+		}
+
+		dir = filepath.Dir(dir)
+	}
+
+	slices.Reverse(files)
+
+	return files
+}
+
+func (f *scopesFinder) configFile(dir string) string {
+	file, ok := f.configFiles[dir]
+	if ok {
+		return file
+	}
+
+	for _, name := range config.FileNames {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			file = path
+			break
+		}
+	}
+
+	f.configFiles[dir] = file
+
+	return file
+}
+
+// findConfigScopes returns the scopes of the packages matching the arguments,
+// or nil if the nested configuration files are not used.
+// The nested configuration files are only used when enabled (`run.nested-configs`), or by the multi-module runs.
+func (c *runCommand) findConfigScopes(args []string) ([]configScope, error) {
+	if !c.cfg.Run.NestedConfigs && !c.cfg.Run.MultiModule {
+		return nil, nil
+	}
+
+	// The configuration file set by the options applies to all the packages.
+	if c.opts.Config != "" || c.opts.NoConfig {
+		return nil, nil
+	}
+
+	workDir, err := fsutils.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	skipDirs, err := newSkipDirs(c.log, c.cfg, args)
+	if err != nil {
+		return nil, err
+	}
+
+	skipDir := func(dir string) bool {
+		return skipDirs.SkipsDir(relPath(dir))
+	}
+
+	return newScopesFinder(workDir, c.rootConfigFile(), skipDir).find(args)
+}
+
+// runNestedConfigsAnalysis executes the linters on the packages of each scope, from the working directory.
+func (c *runCommand) runNestedConfigsAnalysis(ctx context.Context, scopes []configScope) ([]result.Issue, error) {
+	sw := timeutils.NewStopwatch("pkgcache", c.log.Child(logutils.DebugKeyStopwatch))
+
+	pkgCache, err := pkgcache.NewCache(sw, c.log.Child(logutils.DebugKeyPkgCache))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build packages cache: %w", err)
+	}
+
+	res, err := c.runScopesAnalysis(ctx, pkgCache, scopes, "")
+	if err != nil {
+		return nil, err
+	}
+
+	err = processMergedIssues(c.log, c.cfg, c.lineCache, c.fileCache, res)
+	if err != nil {
+		return nil, err
+	}
+
+	mergeReportData(c.reportData, res.reportData)
+
+	return res.issues, nil
+}
+
+// runScopesAnalysis executes the linters on the packages of each scope, with the configuration of the scope.
+// The issues are not processed for the output: the limits of the configuration of the run apply to the merged issues.
+func (c *runCommand) runScopesAnalysis(ctx context.Context, pkgCache *pkgcache.Cache, scopes []configScope, dir string) (*analysisResult, error) {
+	// The configurations of the scopes are read by the viper instance of the command.
+	defer c.restoreRootConfig()
+
+	merged := &analysisResult{reportData: &report.Data{}}
+
+	for _, scope := range scopes {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		cfg, err := c.loadScopeConfig(scope)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := analyzePackages(ctx, c.log, cfg, c.goenv, pkgCache, scope.args, analysisOptions{dir: dir, deferOutput: true})
+		if err != nil {
+			return nil, err
+		}
+
+		mergeAnalysisResult(merged, res)
+	}
+
+	return merged, nil
+}
+
+// loadScopeConfig loads the configuration of the run with the nested configuration files of the scope.
+func (c *runCommand) loadScopeConfig(scope configScope) (*config.Config, error) {
+	if len(scope.files) == 0 {
+		return c.cfg, nil
+	}
+
+	opts := c.opts.LoaderOptions
+	nestedFiles := scope.files
+
+	if rootConfig := c.rootConfigFile(); rootConfig != "" {
+		opts.Config = rootConfig
+	} else {
+		// Without configuration file for the run, the outermost nested configuration file is the base configuration.
+		opts.Config, nestedFiles = nestedFiles[0], nestedFiles[1:]
+	}
+
+	cfg := config.NewDefault()
+
+	loader := config.NewLoader(c.log.Child(logutils.DebugKeyConfigReader), c.viper, c.cmd.Flags(), opts, cfg, scope.args)
+	loader.SetNestedConfigFiles(nestedFiles)
+
+	if err := loader.Load(config.LoadOptions{CheckDeprecation: true, Validation: true}); err != nil {
+		return nil, fmt.Errorf("can't load config: %w", err)
+	}
+
+	cfg.InternalCmdTest = c.cfg.InternalCmdTest
+
+	return cfg, nil
+}
+
+// rootConfigFile returns the absolute path of the configuration file of the run, or an empty string.
+func (c *runCommand) rootConfigFile() string {
+	if c.rootConfig == "" {
+		return ""
+	}
+
+	abs, err := filepath.Abs(c.rootConfig)
+	if err != nil {
+		return c.rootConfig
+	}
+
+	return abs
+}
+
+// mergeAnalysisResult adds the issues, the packages, the exclusions, and the report of an analysis to the merged result.
+func mergeAnalysisResult(dst, src *analysisResult) {
+	mergeReportData(dst.reportData, src.reportData)
+
+	dst.issues = append(dst.issues, src.issues...)
+	dst.reported = append(dst.reported, src.reported...)
+	dst.packages = append(dst.packages, src.packages...)
+	dst.exclusions = append(dst.exclusions, src.exclusions...)
+}
+
+// mergeReportData adds the warnings, the error, and the suppressed issues of an analysis to the report.
+func mergeReportData(dst, src *report.Data) {
+	dst.Warnings = append(dst.Warnings, src.Warnings...)
+	dst.Suppressed = append(dst.Suppressed, src.Suppressed...)
+
+	if src.Error != "" {
+		dst.Error = src.Error
+	}
+}
+
+// restoreRootConfig reads again the configuration file of the run, after the reading of other configuration files.
+func (c *runCommand) restoreRootConfig() {
+	if c.rootConfig == "" {
+		return
+	}
+
+	c.viper.SetConfigFile(c.rootConfig)
+	_ = c.viper.ReadInConfig()
+}
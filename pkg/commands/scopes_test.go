@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_scopesFinder_find(t *testing.T) {
+	root := t.TempDir()
+
+	for _, file := range []string{
+		"go.mod",
+		".golangci.yml",
+		"a.go",
+		"cmd/main.go",
+		"legacy/.golangci.yml",
+		"legacy/l.go",
+		"legacy/old/.golangci.yaml",
+		"legacy/old/o.go",
+		"legacy/testdata/t.go",
+		"legacy/skipped/s.go",
+		"nested/go.mod",
+		"nested/n.go",
+	} {
+		path := filepath.Join(root, filepath.FromSlash(file))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, nil, 0o600))
+	}
+
+	skipDir := func(dir string) bool { return filepath.Base(dir) == "skipped" }
+
+	finder := newScopesFinder(root, filepath.Join(root, ".golangci.yml"), skipDir)
+
+	scopes, err := finder.find([]string{filepath.Join(root, "...")})
+	require.NoError(t, err)
+
+	legacyConfig := filepath.Join(root, "legacy", ".golangci.yml")
+
+	expected := []configScope{
+		{
+			args: []string{root, filepath.Join(root, "cmd")},
+		},
+		{
+			files: []string{legacyConfig},
+			args:  []string{filepath.Join(root, "legacy")},
+		},
+		{
+			files: []string{legacyConfig, filepath.Join(root, "legacy", "old", ".golangci.yaml")},
+			args:  []string{filepath.Join(root, "legacy", "old")},
+		},
+	}
+
+	assert.Equal(t, expected, scopes)
+}
+
+func Test_scopesFinder_find_noNestedConfig(t *testing.T) {
+	root := t.TempDir()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "a"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "a", "a.go"), nil, 0o600))
+
+	finder := newScopesFinder(root, "", func(string) bool { return false })
+
+	scopes, err := finder.find([]string{filepath.Join(root, "...")})
+	require.NoError(t, err)
+
+	assert.Nil(t, scopes)
+}
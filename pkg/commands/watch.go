@@ -0,0 +1,476 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fatih/color"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/snowmerak/golangci-lint/internal/cache"
+	"github.com/snowmerak/golangci-lint/internal/pkgcache"
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/exitcodes"
+	"github.com/snowmerak/golangci-lint/pkg/fsutils"
+	"github.com/snowmerak/golangci-lint/pkg/lint"
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+	"github.com/snowmerak/golangci-lint/pkg/printers"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+	"github.com/snowmerak/golangci-lint/pkg/result/processors"
+	"github.com/snowmerak/golangci-lint/pkg/timeutils"
+)
+
+// The delay without changes before starting an analysis.
+const watchDebounceDelay = 300 * time.Millisecond
+
+// The files describing the module or the workspace: their changes affect all the packages.
+var watchedModuleFiles = []string{"go.mod", "go.sum", "go.work", "go.work.sum"}
+
+// watchState is the state kept between the cycles of the watch mode.
+type watchState struct {
+	args []string
+
+	pkgCache *pkgcache.Cache
+	tracker  *lint.ChangeTracker
+
+	skipDirs  *processors.SkipDirs
+	skipFiles *processors.SkipFiles
+
+	// The analyzed packages, by ID.
+	packages map[string]*packages.Package
+
+	// The issues of the analyzed packages, before the output processing.
+	// The issues about the configuration (ex: the expired suppressions) are reported again by each analysis: they are not kept.
+	issues []result.Issue
+
+	// The issues of the last report, after the output processing.
+	output []result.Issue
+}
+
+// executeWatch runs the watch mode: the exit code is the one of the last analysis.
+func (c *runCommand) executeWatch(args []string) {
+	if c.opts.UseDaemon || c.opts.DaemonSocket != "" {
+		c.log.Errorf("Running error: the options --watch and --daemon can't be combined")
+		c.exitCode = exitcodes.Failure
+		return
+	}
+
+	if c.cfg.Run.MultiModule {
+		c.log.Errorf("Running error: the options --watch and --multi-module can't be combined")
+		c.exitCode = exitcodes.Failure
+		return
+	}
+
+	if err := c.runWatch(args); err != nil {
+		c.log.Errorf("Running error: %s", err)
+		c.exitCode = exitcodes.Failure
+		return
+	}
+
+	if c.reportData.Error != "" && c.exitCode == exitcodes.Success {
+		c.exitCode = exitcodes.ErrorWasLogged
+	}
+}
+
+// runWatch analyzes the packages, then analyzes again the packages affected by the changes of the files until interrupted.
+func (c *runCommand) runWatch(args []string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := c.goenv.Discover(ctx); err != nil {
+		c.log.Warnf("Failed to discover go env: %s", err)
+	}
+
+	if !logutils.HaveDebugTag(logutils.DebugKeyLintersOutput) {
+		// Don't allow linters and loader to print anything
+		log.SetOutput(io.Discard)
+		savedStdout, savedStderr := c.setOutputToDevNull()
+		defer func() {
+			os.Stdout, os.Stderr = savedStdout, savedStderr
+		}()
+	}
+
+	enabledLintersMap, err := c.dbManager.GetEnabledLintersMap()
+	if err != nil {
+		return err
+	}
+
+	c.printDeprecatedLinterMessages(enabledLintersMap)
+
+	// Fills linters information for the JSON printer.
+	for _, lc := range c.dbManager.GetAllSupportedLinterConfigs() {
+		isEnabled := enabledLintersMap[lc.Name()] != nil
+		c.reportData.AddLinter(lc.Name(), isEnabled, lc.EnabledByDefault, lc.Linter.Desc(), lc.OriginalURL, lc.InPresets)
+	}
+
+	sw := timeutils.NewStopwatch("pkgcache", c.log.Child(logutils.DebugKeyStopwatch))
+
+	pkgCache, err := pkgcache.NewCache(sw, c.log.Child(logutils.DebugKeyPkgCache))
+	if err != nil {
+		return fmt.Errorf("failed to build packages cache: %w", err)
+	}
+
+	state := &watchState{
+		args:     args,
+		pkgCache: pkgCache,
+		tracker:  lint.NewChangeTracker(),
+		packages: map[string]*packages.Package{},
+	}
+
+	if err = c.setupWatchFilters(state); err != nil {
+		return err
+	}
+
+	watcher, err := c.newWatcher(state)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = watcher.Close() }()
+
+	if err = c.runWatchCycle(ctx, state, nil); err != nil {
+		c.log.Errorf("Running error: %s", err)
+	}
+
+	for {
+		c.cmd.Println(color.CyanString("Watching for changes..."))
+
+		changes, err := watcher.Next(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return err
+		}
+
+		if err = c.runWatchCycle(ctx, state, changes); err != nil {
+			c.log.Errorf("Running error: %s", err)
+		}
+	}
+}
+
+// runWatchCycle analyzes the packages affected by the changes (all the packages without changes), and prints the report.
+func (c *runCommand) runWatchCycle(ctx context.Context, state *watchState, changes []string) error {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Run.Timeout)
+	defer cancel()
+
+	startedAt := time.Now()
+
+	cache.ForgetFileHash(changes...)
+
+	fullRun := len(changes) == 0
+
+	for _, change := range changes {
+		switch {
+		case c.isConfigFile(change):
+			if err := c.reloadConfig(state); err != nil {
+				return err
+			}
+
+			fullRun = true
+
+		case slices.Contains(watchedModuleFiles, filepath.Base(change)):
+			fullRun = true
+		}
+	}
+
+	// The exclusions which matched no issue can only be known by analyzing all the packages.
+	if c.cfg.Issues.ReportUnusedExcludes {
+		fullRun = true
+	}
+
+	args := state.args
+	if !fullRun {
+		args, fullRun = affectedDirs(state.tracker, len(state.packages), state.skipDirs, changes)
+		if fullRun {
+			args = state.args
+		} else if len(args) == 0 {
+			c.log.Infof("No packages to analyze")
+			return nil
+		}
+	}
+
+	res, err := analyzePackages(ctx, c.log, c.cfg, c.goenv, state.pkgCache, args, analysisOptions{deferOutput: true})
+	if err != nil {
+		return err
+	}
+
+	if fullRun {
+		state.packages = map[string]*packages.Package{}
+	} else {
+		res.issues = append(issuesOutsideDirs(state.issues, args), res.issues...)
+	}
+
+	for _, pkg := range res.packages {
+		state.packages[pkg.ID] = pkg
+	}
+
+	state.tracker.Track(mapValues(state.packages))
+
+	// The output processing modifies the issues.
+	state.issues = slices.Clone(res.issues)
+
+	// The issues of the cycles are compared by fingerprint.
+	res.fingerprints = true
+
+	fileCache := fsutils.NewFileCache()
+
+	err = processMergedIssues(c.log, c.cfg, fsutils.NewLineCache(fileCache), fileCache, res)
+	if err != nil {
+		return err
+	}
+
+	issues := res.issues
+
+	c.reportData.Warnings = res.reportData.Warnings
+	c.reportData.Error = res.reportData.Error
+	c.reportData.Suppressed = res.reportData.Suppressed
+	c.reportData.ExitCode = c.getExitCodeIfIssuesFound(issues)
+
+	previous := state.output
+	state.output = issues
+
+	c.setExitCodeIfIssuesFound(issues)
+
+	if changes == nil {
+		if err = c.printer.Print(issues); err != nil {
+			return err
+		}
+
+		c.printStats(issues)
+
+		return nil
+	}
+
+	return c.printWatchReport(changes, len(res.packages), previous, issues, time.Since(startedAt))
+}
+
+func (c *runCommand) printWatchReport(changes []string, analyzed int, previous, issues []result.Issue, duration time.Duration) error {
+	c.cmd.Println()
+	c.cmd.Printf("%d changed files, %d packages analyzed in %s\n",
+		len(changes), analyzed, duration.Round(time.Millisecond))
+
+	fixed, added := diffIssues(previous, issues)
+
+	if len(added) > 0 {
+		c.cmd.Println(color.RedString("New issues:"))
+
+		if err := c.printer.Print(added); err != nil {
+			return err
+		}
+	}
+
+	if len(fixed) > 0 {
+		c.cmd.Println(color.GreenString("Fixed issues:"))
+
+		for i := range fixed {
+			c.cmd.Printf("  %s:%d: %s (%s)\n", fixed[i].FilePath(), fixed[i].Line(), fixed[i].Text, fixed[i].FromLinter)
+		}
+	}
+
+	c.cmd.Printf("%d issues (%d new, %d fixed)\n", len(issues), len(added), len(fixed))
+
+	return nil
+}
+
+// affectedDirs returns the directories of the packages affected by the changes, as arguments of an analysis,
+// without the directories skipped by the configuration.
+// It reports whether all the analyzed packages are affected.
+func affectedDirs(tracker *lint.ChangeTracker, analyzed int, skipDirs *processors.SkipDirs, changes []string) ([]string, bool) {
+	affected := tracker.AffectedPackages(changes)
+	if len(affected) == analyzed {
+		return nil, true
+	}
+
+	var dirs []string
+	for _, pkg := range affected {
+		if len(pkg.GoFiles) == 0 {
+			continue
+		}
+
+		dir := filepath.Dir(pkg.GoFiles[0])
+		if slices.Contains(dirs, dir) || skipDirs.SkipsDir(relPath(dir)) {
+			continue
+		}
+
+		dirs = append(dirs, dir)
+	}
+
+	slices.Sort(dirs)
+
+	return dirs, false
+}
+
+// issuesOutsideDirs returns the issues of a previous analysis that are not in the analyzed directories.
+func issuesOutsideDirs(issues []result.Issue, dirs []string) []result.Issue {
+	var kept []result.Issue
+
+	for i := range issues {
+		dir, err := filepath.Abs(filepath.Dir(issues[i].FilePath()))
+		if err != nil || !slices.Contains(dirs, dir) {
+			kept = append(kept, issues[i])
+		}
+	}
+
+	return kept
+}
+
+// reloadConfig loads the configuration again: the previous configuration is kept if the new one is invalid.
+func (c *runCommand) reloadConfig(state *watchState) error {
+	cfg := config.NewDefault()
+
+	loader := config.NewLoader(c.log.Child(logutils.DebugKeyConfigReader), c.viper, c.cmd.Flags(), c.opts.LoaderOptions, cfg, state.args)
+
+	if err := loader.Load(config.LoadOptions{CheckDeprecation: true, Validation: true}); err != nil {
+		return fmt.Errorf("can't load config: %w", err)
+	}
+
+	cfg.InternalCmdTest = c.cfg.InternalCmdTest
+
+	printer, err := printers.NewPrinter(c.log, &cfg.Output, c.reportData)
+	if err != nil {
+		return err
+	}
+
+	c.cfg = cfg
+	c.printer = printer
+
+	c.cmd.Println(color.CyanString("Configuration reloaded"))
+
+	return c.setupWatchFilters(state)
+}
+
+// setupWatchFilters creates the matchers of the skipped directories and files of the configuration.
+func (c *runCommand) setupWatchFilters(state *watchState) error {
+	skipFiles, err := processors.NewSkipFiles(c.cfg.Issues.ExcludeFiles, c.cfg.Output.PathPrefix)
+	if err != nil {
+		return err
+	}
+
+	skipDirs, err := newSkipDirs(c.log, c.cfg, state.args)
+	if err != nil {
+		return err
+	}
+
+	state.skipDirs, state.skipFiles = skipDirs, skipFiles
+
+	return nil
+}
+
+func (c *runCommand) newWatcher(state *watchState) (*fsutils.Watcher, error) {
+	workDir, err := fsutils.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	match := func(path string) bool {
+		if c.isConfigFile(path) || slices.Contains(watchedModuleFiles, filepath.Base(path)) {
+			return true
+		}
+
+		return filepath.Ext(path) == ".go" && !state.skipFiles.SkipsFile(relPath(path))
+	}
+
+	skipDir := func(dir string) bool {
+		return state.skipDirs.SkipsDir(relPath(dir))
+	}
+
+	watcher, err := fsutils.NewWatcher(c.log.Child(logutils.DebugKeyWatch), watchDebounceDelay, match, skipDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = watcher.AddTree(workDir); err != nil {
+		_ = watcher.Close()
+		return nil, err
+	}
+
+	// The configuration file can be outside the working directory.
+	if configFile := c.viper.ConfigFileUsed(); configFile != "" {
+		configDir, err := filepath.Abs(filepath.Dir(configFile))
+		if err == nil && !isSubDir(workDir, configDir) {
+			err = watcher.AddDir(configDir)
+		}
+
+		if err != nil {
+			c.log.Warnf("Can't watch the configuration file: %v", err)
+		}
+	}
+
+	return watcher, nil
+}
+
+func (c *runCommand) isConfigFile(path string) bool {
+	if configFile := c.viper.ConfigFileUsed(); configFile != "" {
+		if abs, err := filepath.Abs(configFile); err == nil && abs == path {
+			return true
+		}
+	}
+
+	return !c.opts.NoConfig && c.opts.Config == "" && slices.Contains(config.FileNames, filepath.Base(path))
+}
+
+func relPath(path string) string {
+	rel, err := fsutils.ShortestRelPath(path, "")
+	if err != nil {
+		return path
+	}
+
+	return rel
+}
+
+// diffIssues returns the issues of previous not in issues (fixed), and the issues of issues not in previous (added).
+// The issues are compared by fingerprint: they are the same even if their positions changed.
+func diffIssues(previous, issues []result.Issue) (fixed, added []result.Issue) {
+	count := map[string]int{}
+	for i := range previous {
+		count[previous[i].Fingerprint()]++
+	}
+
+	for i := range issues {
+		fp := issues[i].Fingerprint()
+		if count[fp] > 0 {
+			count[fp]--
+			continue
+		}
+
+		added = append(added, issues[i])
+	}
+
+	for i := len(previous) - 1; i >= 0; i-- {
+		fp := previous[i].Fingerprint()
+		if count[fp] > 0 {
+			count[fp]--
+			fixed = append(fixed, previous[i])
+		}
+	}
+
+	slices.Reverse(fixed)
+
+	return fixed, added
+}
+
+func isSubDir(root, dir string) bool {
+	rel, err := filepath.Rel(root, dir)
+
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+func mapValues[K comparable, V any](m map[K]V) []V {
+	values := make([]V, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+
+	return values
+}
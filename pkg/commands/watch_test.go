@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"go/token"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+func Test_diffIssues(t *testing.T) {
+	newIssue := func(text, file string, line int) result.Issue {
+		return result.Issue{
+			FromLinter: "linter",
+			Text:       text,
+			Pos:        token.Position{Filename: file, Line: line},
+		}
+	}
+
+	previous := []result.Issue{
+		newIssue("a", "a.go", 1),
+		newIssue("b", "a.go", 2),
+		newIssue("dup", "b.go", 3),
+		newIssue("dup", "b.go", 4),
+	}
+
+	issues := []result.Issue{
+		// The position changed: the issue is the same.
+		newIssue("a", "a.go", 10),
+		newIssue("dup", "b.go", 3),
+		newIssue("c", "c.go", 1),
+	}
+
+	fixed, added := diffIssues(previous, issues)
+
+	assert.Equal(t, []result.Issue{newIssue("b", "a.go", 2), newIssue("dup", "b.go", 4)}, fixed)
+	assert.Equal(t, []result.Issue{newIssue("c", "c.go", 1)}, added)
+}
+
+func Test_issuesOutsideDirs(t *testing.T) {
+	newIssue := func(file string) result.Issue {
+		return result.Issue{FromLinter: "linter", Pos: token.Position{Filename: file, Line: 1}}
+	}
+
+	dir, err := filepath.Abs("a")
+	require.NoError(t, err)
+
+	issues := []result.Issue{
+		newIssue(filepath.Join("a", "a.go")),
+		newIssue(filepath.Join("a", "b", "b.go")),
+		newIssue("c.go"),
+	}
+
+	expected := []result.Issue{newIssue(filepath.Join("a", "b", "b.go")), newIssue("c.go")}
+
+	assert.Equal(t, expected, issuesOutsideDirs(issues, []string{dir}))
+}
+
+func Test_isSubDir(t *testing.T) {
+	assert.True(t, isSubDir("/a", "/a"))
+	assert.True(t, isSubDir("/a", "/a/b"))
+	assert.True(t, isSubDir("/a", "/a/..b"))
+	assert.False(t, isSubDir("/a", "/"))
+	assert.False(t, isSubDir("/a", "/ab"))
+}
@@ -11,7 +11,10 @@ import (
 
 // Config encapsulates the config data specified in the golangci-lint YAML config file.
 type Config struct {
-	cfgDir string // The directory containing the golangci-lint config file.
+	cfgDir  string // The directory containing the golangci-lint config file.
+	cfgFile string // The path of the golangci-lint config file, relative to the working directory.
+
+	provenance *Provenance // The origins of the values.
 
 	Run Run `mapstructure:"run"`
 
@@ -31,6 +34,20 @@ func (c *Config) GetConfigDir() string {
 	return c.cfgDir
 }
 
+// GetConfigFile returns the path of the golangci config file, or an empty string.
+func (c *Config) GetConfigFile() string {
+	return c.cfgFile
+}
+
+// GetSource returns the origin of the value of a key (ex: `issues.exclude-rules`).
+func (c *Config) GetSource(key string) Source {
+	if c.provenance == nil {
+		return Source{Kind: SourceDefault}
+	}
+
+	return c.provenance.Source(key)
+}
+
 func (c *Config) Validate() error {
 	validators := []func() error{
 		c.Run.Validate,
@@ -75,19 +92,19 @@ func IsGoGreaterThanOrEqual(current, limit string) bool {
 	return v1.GreaterThanOrEqual(l)
 }
 
-func detectGoVersion() string {
+func detectGoVersion() (string, Source) {
 	file, _ := gomoddirectives.GetModuleFile()
 
 	if file != nil && file.Go != nil && file.Go.Version != "" {
-		return file.Go.Version
+		return file.Go.Version, Source{Kind: SourceFile, Name: "go.mod", Line: file.Go.Syntax.Start.Line}
 	}
 
 	v := os.Getenv("GOVERSION")
 	if v != "" {
-		return v
+		return v, Source{Kind: SourceEnv, Name: "GOVERSION"}
 	}
 
-	return "1.17"
+	return "1.17", Source{Kind: SourceDefault}
 }
 
 // Trims the Go version to keep only M.m.
@@ -0,0 +1,263 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/snowmerak/golangci-lint/pkg/fsutils"
+)
+
+const (
+	// keyExtends is the key of the configuration files extended by a configuration file.
+	keyExtends = "extends"
+
+	// keyMerge is the key of the merge semantics of the lists of a configuration file.
+	keyMerge = "merge"
+)
+
+// mergeRules are the merge semantics of the lists of a configuration file, by dotted key (ex: `linters.enable`).
+// The lists are replaced by default.
+type mergeRules struct {
+	// The lists appended to the lists of the base configuration.
+	appended map[string]bool
+	// The lists whose elements are removed from the lists of the base configuration.
+	removed map[string]bool
+}
+
+// configFileReader reads configuration files with their extended configuration files.
+type configFileReader struct {
+	// The files being read, to detect the cycles.
+	stack []string
+
+	// The origins of the values, set in the order of the merge of the files.
+	provenance *Provenance
+}
+
+// read returns the settings of the configuration file merged onto the settings of the files it extends,
+// and the merge semantics of its lists.
+func (r *configFileReader) read(file string) (map[string]any, *mergeRules, error) {
+	file, err := filepath.Abs(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, f := range r.stack {
+		if f == file {
+			return nil, nil, fmt.Errorf("cycle of extended config files: %s -> %s", strings.Join(r.stack, " -> "), file)
+		}
+	}
+
+	r.stack = append(r.stack, file)
+	defer func() { r.stack = r.stack[:len(r.stack)-1] }()
+
+	v := viper.New()
+	v.SetConfigFile(file)
+
+	// Assume YAML if the file has no extension.
+	if filepath.Ext(file) == "" {
+		v.SetConfigType("yaml")
+	}
+
+	if err = v.ReadInConfig(); err != nil {
+		return nil, nil, fmt.Errorf("can't read config file %s: %w", file, err)
+	}
+
+	settings := v.AllSettings()
+
+	extends, err := toStrings(settings[keyExtends])
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: invalid %q: %w", file, keyExtends, err)
+	}
+
+	rules, err := parseMergeRules(settings[keyMerge])
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: invalid %q: %w", file, keyMerge, err)
+	}
+
+	delete(settings, keyExtends)
+	delete(settings, keyMerge)
+
+	base := map[string]any{}
+
+	for _, extended := range extends {
+		path, err := resolveExtendedConfigFile(filepath.Dir(file), extended)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", file, err)
+		}
+
+		extendedSettings, extendedRules, err := r.read(path)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		base = mergeSettings(base, extendedSettings, extendedRules, "")
+	}
+
+	r.addProvenance(file)
+
+	return mergeSettings(base, settings, rules, ""), rules, nil
+}
+
+// addProvenance sets the origins of the values of a configuration file.
+func (r *configFileReader) addProvenance(file string) {
+	if r.provenance == nil {
+		return
+	}
+
+	if data, err := os.ReadFile(file); err == nil {
+		r.provenance.addFile(file, data)
+	}
+}
+
+// resolveExtendedConfigFile returns the path of an extended configuration file:
+// a file, or a directory containing a configuration file, relative to the directory of the extending file.
+func resolveExtendedConfigFile(dir, path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, filepath.FromSlash(path))
+	}
+
+	if !fsutils.IsDir(path) {
+		return path, nil
+	}
+
+	for _, name := range FileNames {
+		file := filepath.Join(path, name)
+		if _, err := os.Stat(file); err == nil {
+			return file, nil
+		}
+	}
+
+	return "", fmt.Errorf("no config file in the extended directory %s", path)
+}
+
+// mergeSettings merges the settings onto the base settings:
+// the maps are merged recursively, the lists are merged according to the rules, the other values are replaced.
+func mergeSettings(base, settings map[string]any, rules *mergeRules, prefix string) map[string]any {
+	merged := make(map[string]any, len(base)+len(settings))
+	for k, v := range base {
+		merged[k] = v
+	}
+
+	for k, v := range settings {
+		key := prefix + k
+
+		baseMap, baseIsMap := merged[k].(map[string]any)
+		valueMap, valueIsMap := v.(map[string]any)
+
+		switch {
+		case baseIsMap && valueIsMap:
+			merged[k] = mergeSettings(baseMap, valueMap, rules, key+".")
+
+		case rules.appended[key]:
+			merged[k] = append(slices.Clone(toSlice(merged[k])), toSlice(v)...)
+
+		case rules.removed[key]:
+			merged[k] = removeElements(toSlice(merged[k]), toSlice(v))
+
+		default:
+			merged[k] = v
+		}
+	}
+
+	return merged
+}
+
+func parseMergeRules(value any) (*mergeRules, error) {
+	rules := &mergeRules{appended: map[string]bool{}, removed: map[string]bool{}}
+
+	if value == nil {
+		return rules, nil
+	}
+
+	m, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("expected a map, got %T", value)
+	}
+
+	for k, v := range m {
+		keys, err := toStrings(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+
+		var target map[string]bool
+
+		switch k {
+		case "append":
+			target = rules.appended
+		case "remove":
+			target = rules.removed
+		default:
+			return nil, fmt.Errorf("unknown list merge mode %q: only append and remove are allowed", k)
+		}
+
+		for _, key := range keys {
+			target[strings.ToLower(key)] = true
+		}
+	}
+
+	return rules, nil
+}
+
+func removeElements(values, removed []any) []any {
+	result := make([]any, 0, len(values))
+
+	for _, v := range values {
+		found := false
+
+		for _, r := range removed {
+			if reflect.DeepEqual(v, r) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+func toSlice(value any) []any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []any:
+		return v
+	default:
+		rv := reflect.ValueOf(value)
+		if rv.Kind() != reflect.Slice {
+			return []any{value}
+		}
+
+		values := make([]any, rv.Len())
+		for i := range values {
+			values[i] = rv.Index(i).Interface()
+		}
+
+		return values
+	}
+}
+
+func toStrings(value any) ([]string, error) {
+	var values []string
+
+	for _, v := range toSlice(value) {
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string, got %T", v)
+		}
+
+		values = append(values, s)
+	}
+
+	return values, nil
+}
@@ -0,0 +1,129 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_mergeSettings(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		base     map[string]any
+		settings map[string]any
+		rules    *mergeRules
+		expected map[string]any
+	}{
+		{
+			desc:     "replace lists",
+			base:     map[string]any{"linters": map[string]any{"enable": []any{"a", "b"}, "fast": true}},
+			settings: map[string]any{"linters": map[string]any{"enable": []any{"c"}}},
+			rules:    &mergeRules{},
+			expected: map[string]any{"linters": map[string]any{"enable": []any{"c"}, "fast": true}},
+		},
+		{
+			desc:     "append lists",
+			base:     map[string]any{"linters": map[string]any{"enable": []any{"a", "b"}}},
+			settings: map[string]any{"linters": map[string]any{"enable": []any{"c"}}},
+			rules:    &mergeRules{appended: map[string]bool{"linters.enable": true}},
+			expected: map[string]any{"linters": map[string]any{"enable": []any{"a", "b", "c"}}},
+		},
+		{
+			desc:     "remove elements",
+			base:     map[string]any{"linters": map[string]any{"enable": []any{"a", "b"}}},
+			settings: map[string]any{"linters": map[string]any{"enable": []any{"a"}}},
+			rules:    &mergeRules{removed: map[string]bool{"linters.enable": true}},
+			expected: map[string]any{"linters": map[string]any{"enable": []any{"b"}}},
+		},
+		{
+			desc:     "append to missing list",
+			base:     map[string]any{},
+			settings: map[string]any{"linters": map[string]any{"enable": []any{"c"}}},
+			rules:    &mergeRules{appended: map[string]bool{"linters.enable": true}},
+			expected: map[string]any{"linters": map[string]any{"enable": []any{"c"}}},
+		},
+		{
+			desc:     "replace scalars",
+			base:     map[string]any{"run": map[string]any{"timeout": "1m", "tests": true}},
+			settings: map[string]any{"run": map[string]any{"timeout": "5m"}},
+			rules:    &mergeRules{},
+			expected: map[string]any{"run": map[string]any{"timeout": "5m", "tests": true}},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			merged := mergeSettings(test.base, test.settings, test.rules, "")
+
+			assert.Equal(t, test.expected, merged)
+		})
+	}
+}
+
+func Test_mergeSettings_baseUnchanged(t *testing.T) {
+	enable := make([]any, 2, 10)
+	enable[0], enable[1] = "a", "b"
+
+	base := map[string]any{"linters": map[string]any{"enable": enable}}
+
+	_ = mergeSettings(base, map[string]any{"linters": map[string]any{"enable": []any{"c"}}},
+		&mergeRules{appended: map[string]bool{"linters.enable": true}}, "")
+
+	assert.Equal(t, map[string]any{"linters": map[string]any{"enable": []any{"a", "b"}}}, base)
+	// The spare capacity of the base list is not used.
+	assert.Nil(t, enable[:3][2])
+}
+
+func Test_parseMergeRules(t *testing.T) {
+	rules, err := parseMergeRules(map[string]any{
+		"append": []any{"Linters.Enable"},
+		"remove": []any{"linters.disable"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]bool{"linters.enable": true}, rules.appended)
+	assert.Equal(t, map[string]bool{"linters.disable": true}, rules.removed)
+
+	_, err = parseMergeRules(map[string]any{"prepend": []any{"linters.enable"}})
+	require.Error(t, err)
+
+	_, err = parseMergeRules([]any{"linters.enable"})
+	require.Error(t, err)
+}
+
+func Test_configFileReader_read_cycle(t *testing.T) {
+	dir := t.TempDir()
+
+	a := filepath.Join(dir, "a.yml")
+	b := filepath.Join(dir, "b.yml")
+
+	require.NoError(t, os.WriteFile(a, []byte("extends: b.yml\n"), 0o600))
+	require.NoError(t, os.WriteFile(b, []byte("extends: [a.yml]\n"), 0o600))
+
+	_, _, err := (&configFileReader{}).read(a)
+	require.ErrorContains(t, err, "cycle of extended config files")
+}
+
+func Test_resolveExtendedConfigFile(t *testing.T) {
+	dir := t.TempDir()
+
+	shared := filepath.Join(dir, "shared")
+	require.NoError(t, os.MkdirAll(shared, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(shared, ".golangci.yaml"), []byte("run: {}\n"), 0o600))
+
+	file, err := resolveExtendedConfigFile(dir, "shared")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(shared, ".golangci.yaml"), file)
+
+	file, err = resolveExtendedConfigFile(dir, "base.yml")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "base.yml"), file)
+
+	_, err = resolveExtendedConfigFile(dir, ".")
+	require.Error(t, err)
+}
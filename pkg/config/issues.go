@@ -4,10 +4,24 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
 )
 
 const excludeRuleMinConditionsCount = 2
 
+const (
+	FixModeWrite       = "write"
+	FixModeDiff        = "diff"
+	FixModeInteractive = "interactive"
+)
+
+var AllFixModes = []string{
+	FixModeWrite,
+	FixModeDiff,
+	FixModeInteractive,
+}
+
 var DefaultExcludePatterns = []ExcludePattern{
 	{
 		ID: "EXC0001",
@@ -125,12 +139,27 @@ type Issues struct {
 	WholeFiles        bool   `mapstructure:"whole-files"`
 	Diff              bool   `mapstructure:"new"`
 
-	NeedFix bool `mapstructure:"fix"`
+	Baseline      string `mapstructure:"baseline"`
+	BaselineWrite string `mapstructure:"baseline-write"`
+
+	Suppressions string `mapstructure:"suppressions"`
+
+	NeedFix bool   `mapstructure:"fix"`
+	FixMode string `mapstructure:"fix-mode"`
+
+	// The explanation of the nolint directives added for the issues, disabled if empty.
+	AddNolint string `mapstructure:"add-nolint"`
+
+	ReportUnusedExcludes bool `mapstructure:"report-unused-excludes"`
 
 	ExcludeGeneratedStrict bool `mapstructure:"exclude-generated-strict"` // Deprecated: use ExcludeGenerated instead.
 }
 
 func (i *Issues) Validate() error {
+	if i.FixMode != "" && !slices.Contains(AllFixModes, i.FixMode) {
+		return fmt.Errorf("invalid fix-mode %q, expected one of: %s", i.FixMode, strings.Join(AllFixModes, ", "))
+	}
+
 	for i, rule := range i.ExcludeRules {
 		if err := rule.Validate(); err != nil {
 			return fmt.Errorf("error in exclude rule #%d: %w", i, err)
@@ -150,6 +179,7 @@ func (e *ExcludeRule) Validate() error {
 
 type BaseRule struct {
 	Linters    []string
+	RuleIDs    []string `mapstructure:"rule-ids"`
 	Path       string
 	PathExcept string `mapstructure:"path-except"`
 	Text       string
@@ -182,6 +212,10 @@ func (b *BaseRule) Validate(minConditionsCount int) error {
 		nonBlank++
 	}
 
+	if len(b.RuleIDs) > 0 {
+		nonBlank++
+	}
+
 	// Filtering by path counts as one condition, regardless how it is done (one or both).
 	// Otherwise, a rule with Path and PathExcept set would pass validation
 	// whereas before the introduction of path-except that wouldn't have been precise enough.
@@ -198,7 +232,7 @@ func (b *BaseRule) Validate(minConditionsCount int) error {
 	}
 
 	if nonBlank < minConditionsCount {
-		return fmt.Errorf("at least %d of (text, source, path[-except],  linters) should be set", minConditionsCount)
+		return fmt.Errorf("at least %d of (text, source, path[-except], linters, rule-ids) should be set", minConditionsCount)
 	}
 
 	return nil
@@ -741,10 +741,11 @@ type MndSettings struct {
 }
 
 type NoLintLintSettings struct {
-	RequireExplanation bool     `mapstructure:"require-explanation"`
-	RequireSpecific    bool     `mapstructure:"require-specific"`
-	AllowNoExplanation []string `mapstructure:"allow-no-explanation"`
-	AllowUnused        bool     `mapstructure:"allow-unused"`
+	RequireExplanation  bool     `mapstructure:"require-explanation"`
+	RequireSpecific     bool     `mapstructure:"require-specific"`
+	RequireIssuePattern string   `mapstructure:"require-issue-pattern"`
+	AllowNoExplanation  []string `mapstructure:"allow-no-explanation"`
+	AllowUnused         bool     `mapstructure:"allow-unused"`
 }
 
 type NoNamedReturnsSettings struct {
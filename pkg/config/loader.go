@@ -19,6 +19,9 @@ import (
 
 var errConfigDisabled = errors.New("config is disabled by --no-config")
 
+// FileNames are the names of the configuration files inside a directory, by order of precedence.
+var FileNames = []string{".golangci.yml", ".golangci.yaml", ".golangci.toml", ".golangci.json"}
+
 type LoaderOptions struct {
 	Config   string // Flag only. The path to the golangci config file, as specified with the --config argument.
 	NoConfig bool   // Flag only.
@@ -39,6 +42,10 @@ type Loader struct {
 
 	cfg  *Config
 	args []string
+
+	nestedFiles []string
+
+	provenance *Provenance
 }
 
 func NewLoader(log logutils.Log, v *viper.Viper, fs *pflag.FlagSet, opts LoaderOptions, cfg *Config, args []string) *Loader {
@@ -49,9 +56,22 @@ func NewLoader(log logutils.Log, v *viper.Viper, fs *pflag.FlagSet, opts LoaderO
 		log:   log,
 		cfg:   cfg,
 		args:  args,
+
+		provenance: NewProvenance(),
 	}
 }
 
+// SetNestedConfigFiles sets the configuration files merged onto the configuration file,
+// from the outermost directory to the innermost directory.
+func (l *Loader) SetNestedConfigFiles(files []string) {
+	l.nestedFiles = files
+}
+
+// Provenance returns the origins of the values of the loaded configuration.
+func (l *Loader) Provenance() *Provenance {
+	return l.provenance
+}
+
 func (l *Loader) Load(opts LoadOptions) error {
 	err := l.setConfigFile()
 	if err != nil {
@@ -63,6 +83,10 @@ func (l *Loader) Load(opts LoadOptions) error {
 		return err
 	}
 
+	l.setFlagSources()
+
+	l.cfg.provenance = l.provenance
+
 	l.applyStringSliceHack()
 
 	if opts.CheckDeprecation {
@@ -190,7 +214,12 @@ func (l *Loader) parseConfig() error {
 	if err := l.viper.ReadInConfig(); err != nil {
 		var configFileNotFoundError viper.ConfigFileNotFoundError
 		if errors.As(err, &configFileNotFoundError) {
-			// Load configuration from flags only.
+			err = l.mergeConfigFiles()
+			if err != nil {
+				return err
+			}
+
+			// Load configuration from flags (and nested configuration files) only.
 			err = l.viper.Unmarshal(l.cfg, customDecoderHook())
 			if err != nil {
 				return fmt.Errorf("can't unmarshal config by viper (flags): %w", err)
@@ -207,6 +236,11 @@ func (l *Loader) parseConfig() error {
 		return err
 	}
 
+	err = l.mergeConfigFiles()
+	if err != nil {
+		return err
+	}
+
 	// Load configuration from all sources (flags, file).
 	if err := l.viper.Unmarshal(l.cfg, customDecoderHook()); err != nil {
 		return fmt.Errorf("can't unmarshal config by viper (flags, file): %w", err)
@@ -220,6 +254,53 @@ func (l *Loader) parseConfig() error {
 	return nil
 }
 
+// mergeConfigFiles merges the configuration files extended by the configuration file,
+// and the nested configuration files, onto the configuration read by viper.
+func (l *Loader) mergeConfigFiles() error {
+	usedConfigFile := l.viper.ConfigFileUsed()
+	fromFile := usedConfigFile != "" && usedConfigFile != os.Stdin.Name()
+
+	reader := &configFileReader{provenance: l.provenance}
+
+	// Without extended and nested configuration files, the configuration read by viper is complete.
+	if !l.viper.IsSet(keyExtends) && len(l.nestedFiles) == 0 {
+		if fromFile {
+			reader.addProvenance(usedConfigFile)
+		}
+
+		return nil
+	}
+
+	settings := map[string]any{}
+
+	if fromFile {
+		var err error
+
+		settings, _, err = reader.read(usedConfigFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, file := range l.nestedFiles {
+		rel, err := fsutils.ShortestRelPath(file, "")
+		if err != nil {
+			rel = file
+		}
+
+		l.log.Infof("Merging nested config file %s", rel)
+
+		nestedSettings, rules, err := reader.read(file)
+		if err != nil {
+			return fmt.Errorf("can't merge nested config file: %w", err)
+		}
+
+		settings = mergeSettings(settings, nestedSettings, rules, "")
+	}
+
+	return l.viper.MergeConfigMap(settings)
+}
+
 func (l *Loader) setConfigDir() error {
 	usedConfigFile := l.viper.ConfigFileUsed()
 	if usedConfigFile == "" {
@@ -245,6 +326,7 @@ func (l *Loader) setConfigDir() error {
 	}
 
 	l.cfg.cfgDir = usedConfigDir
+	l.cfg.cfgFile = usedConfigFile
 
 	return nil
 }
@@ -258,28 +340,45 @@ func (l *Loader) applyStringSliceHack() {
 		return
 	}
 
-	l.appendStringSlice("enable", &l.cfg.Linters.Enable)
-	l.appendStringSlice("disable", &l.cfg.Linters.Disable)
-	l.appendStringSlice("presets", &l.cfg.Linters.Presets)
-	l.appendStringSlice("build-tags", &l.cfg.Run.BuildTags)
-	l.appendStringSlice("exclude", &l.cfg.Issues.ExcludePatterns)
+	l.appendStringSlice("enable", "linters.enable", &l.cfg.Linters.Enable)
+	l.appendStringSlice("disable", "linters.disable", &l.cfg.Linters.Disable)
+	l.appendStringSlice("presets", "linters.presets", &l.cfg.Linters.Presets)
+	l.appendStringSlice("build-tags", "run.build-tags", &l.cfg.Run.BuildTags)
+	l.appendStringSlice("exclude", "issues.exclude", &l.cfg.Issues.ExcludePatterns)
 
-	l.appendStringSlice("skip-dirs", &l.cfg.Run.SkipDirs)
-	l.appendStringSlice("skip-files", &l.cfg.Run.SkipFiles)
-	l.appendStringSlice("exclude-dirs", &l.cfg.Issues.ExcludeDirs)
-	l.appendStringSlice("exclude-files", &l.cfg.Issues.ExcludeFiles)
+	l.appendStringSlice("skip-dirs", "run.skip-dirs", &l.cfg.Run.SkipDirs)
+	l.appendStringSlice("skip-files", "run.skip-files", &l.cfg.Run.SkipFiles)
+	l.appendStringSlice("exclude-dirs", "issues.exclude-dirs", &l.cfg.Issues.ExcludeDirs)
+	l.appendStringSlice("exclude-files", "issues.exclude-files", &l.cfg.Issues.ExcludeFiles)
 }
 
-func (l *Loader) appendStringSlice(name string, current *[]string) {
+func (l *Loader) appendStringSlice(name, key string, current *[]string) {
 	if l.fs.Changed(name) {
 		val, _ := l.fs.GetStringSlice(name)
 		*current = append(*current, val...)
+
+		l.provenance.Set(key, Source{Kind: SourceFlag, Name: name})
 	}
 }
 
+// setFlagSources sets the origins of the values of the changed flags bound to configuration keys.
+func (l *Loader) setFlagSources() {
+	if l.fs == nil {
+		return
+	}
+
+	l.fs.Visit(func(f *pflag.Flag) {
+		for _, key := range f.Annotations[BindingAnnotation] {
+			l.provenance.Set(key, Source{Kind: SourceFlag, Name: f.Name})
+		}
+	})
+}
+
 func (l *Loader) handleGoVersion() {
 	if l.cfg.Run.Go == "" {
-		l.cfg.Run.Go = detectGoVersion()
+		var source Source
+		l.cfg.Run.Go, source = detectGoVersion()
+		l.provenance.Set("run.go", source)
 	}
 
 	l.cfg.LintersSettings.Govet.Go = l.cfg.Run.Go
@@ -288,6 +387,7 @@ func (l *Loader) handleGoVersion() {
 
 	if l.cfg.LintersSettings.Gofumpt.LangVersion == "" {
 		l.cfg.LintersSettings.Gofumpt.LangVersion = l.cfg.Run.Go
+		l.provenance.copy("linters-settings.gofumpt.lang-version", "run.go")
 	}
 
 	trimmedGoVersion := trimGoVersion(l.cfg.Run.Go)
@@ -297,12 +397,15 @@ func (l *Loader) handleGoVersion() {
 	// staticcheck related linters.
 	if l.cfg.LintersSettings.Staticcheck.GoVersion == "" {
 		l.cfg.LintersSettings.Staticcheck.GoVersion = trimmedGoVersion
+		l.provenance.copy("linters-settings.staticcheck.go", "run.go")
 	}
 	if l.cfg.LintersSettings.Gosimple.GoVersion == "" {
 		l.cfg.LintersSettings.Gosimple.GoVersion = trimmedGoVersion
+		l.provenance.copy("linters-settings.gosimple.go", "run.go")
 	}
 	if l.cfg.LintersSettings.Stylecheck.GoVersion == "" {
 		l.cfg.LintersSettings.Stylecheck.GoVersion = trimmedGoVersion
+		l.provenance.copy("linters-settings.stylecheck.go", "run.go")
 	}
 
 	os.Setenv("GOSECGOVERSION", l.cfg.Run.Go)
@@ -317,18 +420,21 @@ func (l *Loader) handleDeprecation() error {
 	if len(l.cfg.Run.SkipFiles) > 0 {
 		l.log.Warnf("The configuration option `run.skip-files` is deprecated, please use `issues.exclude-files`.")
 		l.cfg.Issues.ExcludeFiles = l.cfg.Run.SkipFiles
+		l.provenance.copy("issues.exclude-files", "run.skip-files")
 	}
 
 	// Deprecated since v1.57.0
 	if len(l.cfg.Run.SkipDirs) > 0 {
 		l.log.Warnf("The configuration option `run.skip-dirs` is deprecated, please use `issues.exclude-dirs`.")
 		l.cfg.Issues.ExcludeDirs = l.cfg.Run.SkipDirs
+		l.provenance.copy("issues.exclude-dirs", "run.skip-dirs")
 	}
 
 	// The 2 options are true by default.
 	// Deprecated since v1.57.0
 	if !l.cfg.Run.UseDefaultSkipDirs {
 		l.log.Warnf("The configuration option `run.skip-dirs-use-default` is deprecated, please use `issues.exclude-dirs-use-default`.")
+		l.provenance.copy("issues.exclude-dirs-use-default", "run.skip-dirs-use-default")
 	}
 	l.cfg.Issues.UseDefaultExcludeDirs = l.cfg.Run.UseDefaultSkipDirs && l.cfg.Issues.UseDefaultExcludeDirs
 
@@ -336,6 +442,7 @@ func (l *Loader) handleDeprecation() error {
 	// Deprecated since v1.57.0
 	if l.cfg.Run.ShowStats {
 		l.log.Warnf("The configuration option `run.show-stats` is deprecated, please use `output.show-stats`")
+		l.provenance.copy("output.show-stats", "run.show-stats")
 	}
 	l.cfg.Output.ShowStats = l.cfg.Run.ShowStats || l.cfg.Output.ShowStats
 
@@ -350,6 +457,7 @@ func (l *Loader) handleDeprecation() error {
 		}
 
 		l.cfg.Output.Formats = f
+		l.provenance.copy("output.formats", "output.format")
 	}
 
 	for _, format := range l.cfg.Output.Formats {
@@ -363,6 +471,7 @@ func (l *Loader) handleDeprecation() error {
 	if l.cfg.Issues.ExcludeGeneratedStrict {
 		l.log.Warnf("The configuration option `issues.exclude-generated-strict` is deprecated, please use `issues.exclude-generated`")
 		l.cfg.Issues.ExcludeGenerated = "strict" // Don't use the constants to avoid cyclic dependencies.
+		l.provenance.copy("issues.exclude-generated", "issues.exclude-generated-strict")
 	}
 
 	l.handleLinterOptionDeprecations()
@@ -436,6 +545,7 @@ func (l *Loader) handleLinterOptionDeprecations() {
 		l.log.Warnf("The configuration option `linters.sloglint.context-only` is deprecated, please use `linters.sloglint.context`.")
 		if l.cfg.LintersSettings.SlogLint.Context == "" {
 			l.cfg.LintersSettings.SlogLint.Context = "all"
+			l.provenance.copy("linters-settings.sloglint.context", "linters-settings.sloglint.context-only")
 		}
 	}
 
@@ -466,6 +576,10 @@ func (l *Loader) handleEnableOnlyOption() error {
 			Enable:     only,
 			DisableAll: true,
 		}
+
+		l.provenance.reset("linters")
+		l.provenance.Set("linters.enable", Source{Kind: SourceFlag, Name: "enable-only"})
+		l.provenance.Set("linters.disable-all", Source{Kind: SourceFlag, Name: "enable-only"})
 	}
 
 	return nil
@@ -0,0 +1,104 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+)
+
+func TestLoader_nestedConfigFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	base := filepath.Join(dir, ".golangci.yml")
+	require.NoError(t, os.WriteFile(base, []byte(`
+linters:
+  disable-all: true
+  enable: [errcheck, govet]
+linters-settings:
+  funlen:
+    lines: 60
+    statements: 40
+`), 0o600))
+
+	nested := filepath.Join(dir, "legacy", ".golangci.yml")
+	require.NoError(t, os.MkdirAll(filepath.Dir(nested), 0o755))
+	require.NoError(t, os.WriteFile(nested, []byte(`
+linters:
+  enable: [funlen]
+linters-settings:
+  funlen:
+    lines: 200
+`), 0o600))
+
+	v := viper.New()
+	cfg := NewDefault()
+
+	loader := NewLoader(logutils.NewStderrLog("skip"), v, pflag.NewFlagSet("test", pflag.ContinueOnError), LoaderOptions{Config: base}, cfg, nil)
+	loader.SetNestedConfigFiles([]string{nested})
+
+	require.NoError(t, loader.Load(LoadOptions{}))
+
+	// The lists are replaced.
+	assert.Equal(t, []string{"funlen"}, cfg.Linters.Enable)
+	assert.True(t, cfg.Linters.DisableAll)
+
+	// The maps are merged.
+	assert.Equal(t, 200, cfg.LintersSettings.Funlen.Lines)
+	assert.Equal(t, 40, cfg.LintersSettings.Funlen.Statements)
+
+	// The used configuration file is the base configuration file.
+	assert.Equal(t, base, v.ConfigFileUsed())
+}
+
+func TestLoader_extends(t *testing.T) {
+	dir := t.TempDir()
+
+	shared := filepath.Join(dir, "shared", ".golangci.yml")
+	require.NoError(t, os.MkdirAll(filepath.Dir(shared), 0o755))
+	require.NoError(t, os.WriteFile(shared, []byte(`
+linters:
+  disable-all: true
+  enable: [errcheck, govet, unused]
+  disable: [gosec, lll]
+linters-settings:
+  funlen:
+    lines: 60
+    statements: 40
+`), 0o600))
+
+	file := filepath.Join(dir, "project", ".golangci.yml")
+	require.NoError(t, os.MkdirAll(filepath.Dir(file), 0o755))
+	require.NoError(t, os.WriteFile(file, []byte(`
+extends: ../shared
+merge:
+  append: [linters.enable]
+  remove: [linters.disable]
+linters:
+  enable: [funlen]
+  disable: [lll]
+linters-settings:
+  funlen:
+    lines: 200
+`), 0o600))
+
+	v := viper.New()
+	cfg := NewDefault()
+
+	loader := NewLoader(logutils.NewStderrLog("skip"), v, pflag.NewFlagSet("test", pflag.ContinueOnError), LoaderOptions{Config: file}, cfg, nil)
+
+	require.NoError(t, loader.Load(LoadOptions{}))
+
+	assert.True(t, cfg.Linters.DisableAll)
+	assert.Equal(t, []string{"errcheck", "govet", "unused", "funlen"}, cfg.Linters.Enable)
+	assert.Equal(t, []string{"gosec"}, cfg.Linters.Disable)
+
+	assert.Equal(t, 200, cfg.LintersSettings.Funlen.Lines)
+	assert.Equal(t, 40, cfg.LintersSettings.Funlen.Statements)
+}
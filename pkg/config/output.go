@@ -20,6 +20,12 @@ const (
 	OutFormatGithubActions     = "github-actions" // Deprecated
 	OutFormatTeamCity          = "teamcity"
 	OutFormatSarif             = "sarif"
+	OutFormatRDJSON            = "rdjson"
+	OutFormatRDJSONL           = "rdjsonl"
+	OutFormatMarkdown          = "markdown"
+
+	// OutFormatTemplate is the prefix of the formats rendering the issues with a template file: `template:<path>`.
+	OutFormatTemplate = "template"
 )
 
 var AllOutputFormats = []string{
@@ -35,6 +41,9 @@ var AllOutputFormats = []string{
 	OutFormatGithubActions,
 	OutFormatTeamCity,
 	OutFormatSarif,
+	OutFormatRDJSON,
+	OutFormatRDJSONL,
+	OutFormatMarkdown,
 }
 
 type Output struct {
@@ -46,6 +55,9 @@ type Output struct {
 	SortOrder       []string      `mapstructure:"sort-order"`
 	PathPrefix      string        `mapstructure:"path-prefix"`
 	ShowStats       bool          `mapstructure:"show-stats"`
+	PrintSuppressed bool          `mapstructure:"print-suppressed"`
+
+	Markdown MarkdownOutput `mapstructure:"markdown"`
 
 	// Deprecated: use Formats instead.
 	Format string `mapstructure:"format"`
@@ -77,9 +89,21 @@ func (o *Output) Validate() error {
 		}
 	}
 
+	if o.Markdown.MaxSize < 0 {
+		return errors.New("markdown.max-size must be greater than or equal to 0")
+	}
+
 	return nil
 }
 
+// MarkdownOutput contains the settings of the `markdown` format.
+type MarkdownOutput struct {
+	// The base URL of the links to the files (ex: `https://github.com/owner/repo/blob/<commit>`).
+	BaseURL string `mapstructure:"base-url"`
+	// The maximal size of the output in bytes: the issues, the summary, the warnings, and the linters are truncated to fit.
+	MaxSize int `mapstructure:"max-size"`
+}
+
 type OutputFormat struct {
 	Format string `mapstructure:"format"`
 	Path   string `mapstructure:"path"`
@@ -90,6 +114,14 @@ func (o *OutputFormat) Validate() error {
 		return errors.New("the format is required")
 	}
 
+	if path, ok := o.TemplatePath(); ok {
+		if path == "" {
+			return fmt.Errorf("the template path of the output format %q is required", o.Format)
+		}
+
+		return nil
+	}
+
 	if !slices.Contains(AllOutputFormats, o.Format) {
 		return fmt.Errorf("unsupported output format %q", o.Format)
 	}
@@ -97,12 +129,29 @@ func (o *OutputFormat) Validate() error {
 	return nil
 }
 
+// TemplatePath returns the path of the template file of a `template:<path>` format.
+func (o *OutputFormat) TemplatePath() (string, bool) {
+	return strings.CutPrefix(o.Format, OutFormatTemplate+":")
+}
+
 type OutputFormats []OutputFormat
 
 func (p *OutputFormats) UnmarshalText(text []byte) error {
 	formats := strings.Split(string(text), ",")
 
 	for _, item := range formats {
+		// The template path is a part of the format: `template:<template path>:<output path>`.
+		if tmpl, ok := strings.CutPrefix(item, OutFormatTemplate+":"); ok {
+			tmplPath, path, _ := strings.Cut(tmpl, ":")
+
+			*p = append(*p, OutputFormat{
+				Path:   path,
+				Format: OutFormatTemplate + ":" + tmplPath,
+			})
+
+			continue
+		}
+
 		format, path, _ := strings.Cut(item, ":")
 
 		*p = append(*p, OutputFormat{
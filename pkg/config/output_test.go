@@ -3,6 +3,7 @@ package config
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -92,6 +93,13 @@ func TestOutput_Validate_error(t *testing.T) {
 			},
 			expected: `unsupported output format "test"`,
 		},
+		{
+			desc: "negative markdown max-size",
+			settings: &Output{
+				Markdown: MarkdownOutput{MaxSize: -1},
+			},
+			expected: "markdown.max-size must be greater than or equal to 0",
+		},
 	}
 
 	for _, test := range testCases {
@@ -130,6 +138,13 @@ func TestOutputFormat_Validate(t *testing.T) {
 				Path:   "/tmp/example.json",
 			},
 		},
+		{
+			desc: "template",
+			settings: &OutputFormat{
+				Format: "template:ci.tmpl",
+				Path:   "./report.txt",
+			},
+		},
 	}
 
 	for _, test := range testCases {
@@ -161,6 +176,13 @@ func TestOutputFormat_Validate_error(t *testing.T) {
 			},
 			expected: `unsupported output format "test"`,
 		},
+		{
+			desc: "template without path",
+			settings: &OutputFormat{
+				Format: "template:",
+			},
+			expected: `the template path of the output format "template:" is required`,
+		},
 	}
 
 	for _, test := range testCases {
@@ -173,3 +195,19 @@ func TestOutputFormat_Validate_error(t *testing.T) {
 		})
 	}
 }
+
+func TestOutputFormats_UnmarshalText(t *testing.T) {
+	var formats OutputFormats
+
+	err := formats.UnmarshalText([]byte("json:report.json,template:ci.tmpl:report.txt,template:slack.tmpl,tab"))
+	require.NoError(t, err)
+
+	expected := OutputFormats{
+		{Format: "json", Path: "report.json"},
+		{Format: "template:ci.tmpl", Path: "report.txt"},
+		{Format: "template:slack.tmpl"},
+		{Format: "tab"},
+	}
+
+	assert.Equal(t, expected, formats)
+}
@@ -0,0 +1,215 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/snowmerak/golangci-lint/pkg/fsutils"
+)
+
+// BindingAnnotation is the annotation of a flag containing the configuration key bound to the flag.
+const BindingAnnotation = "golangci-lint/binding"
+
+// SourceKind is the kind of origin of a configuration value.
+type SourceKind string
+
+const (
+	SourceDefault SourceKind = "default"
+	SourceFile    SourceKind = "file"
+	SourceFlag    SourceKind = "flag"
+	SourceEnv     SourceKind = "env"
+)
+
+// Source is the origin of a configuration value.
+type Source struct {
+	Kind SourceKind
+	// The path of the file (file), the name of the flag (flag), or the name of the environment variable (env).
+	Name string
+	// The line of the value inside the file (file), or 0 if unknown.
+	Line int
+}
+
+func (s Source) String() string {
+	switch s.Kind {
+	case SourceFile:
+		if s.Line > 0 {
+			return fmt.Sprintf("%s:%d", s.Name, s.Line)
+		}
+
+		return s.Name
+
+	case SourceFlag:
+		return "flag --" + s.Name
+
+	case SourceEnv:
+		return "env " + s.Name
+
+	default:
+		return string(SourceDefault)
+	}
+}
+
+// Provenance contains the origins of the configuration values, by dotted key (ex: `run.timeout`).
+// The values without origin are default values.
+type Provenance struct {
+	sources map[string]Source
+}
+
+func NewProvenance() *Provenance {
+	return &Provenance{sources: map[string]Source{}}
+}
+
+// Source returns the origin of the value of a key:
+// the origin of the key, or of its nearest parent key (ex: a list of maps set by a file).
+func (p *Provenance) Source(key string) Source {
+	key = strings.ToLower(key)
+
+	for {
+		if source, ok := p.sources[key]; ok {
+			return source
+		}
+
+		i := strings.LastIndexByte(key, '.')
+		if i < 0 {
+			return Source{Kind: SourceDefault}
+		}
+
+		key = key[:i]
+	}
+}
+
+// Set sets the origin of the value of a key.
+func (p *Provenance) Set(key string, source Source) {
+	p.sources[strings.ToLower(key)] = source
+}
+
+// copy sets the origin of a value computed from another value.
+func (p *Provenance) copy(dst, src string) {
+	p.Set(dst, p.Source(src))
+}
+
+// reset removes the origins of the key and its child keys.
+func (p *Provenance) reset(key string) {
+	key = strings.ToLower(key)
+
+	for k := range p.sources {
+		if k == key || strings.HasPrefix(k, key+".") {
+			delete(p.sources, k)
+		}
+	}
+}
+
+// addFile sets the origins of the values of a configuration file.
+// The lines of the values are only known for the YAML and JSON files, and approximated for the TOML files.
+func (p *Provenance) addFile(file string, data []byte) {
+	name, err := fsutils.ShortestRelPath(file, "")
+	if err != nil {
+		name = file
+	}
+
+	var lines map[string]int
+
+	if filepath.Ext(file) == ".toml" {
+		lines = tomlKeyLines(data)
+	} else {
+		lines = yamlKeyLines(data)
+	}
+
+	for key, line := range lines {
+		if key == keyExtends || key == keyMerge || strings.HasPrefix(key, keyMerge+".") {
+			continue
+		}
+
+		p.Set(key, Source{Kind: SourceFile, Name: name, Line: line})
+	}
+}
+
+// yamlKeyLines returns the lines of the values of a YAML (or JSON) document, by dotted key.
+// Only the values which are not maps are returned: the lists, the scalars, and the empty maps.
+func yamlKeyLines(data []byte) map[string]int {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil || len(doc.Content) == 0 {
+		return nil
+	}
+
+	lines := map[string]int{}
+
+	var walk func(node *yaml.Node, prefix string)
+	walk = func(node *yaml.Node, prefix string) {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+
+			if value.Kind == yaml.AliasNode {
+				value = value.Alias
+			}
+
+			dotted := prefix + strings.ToLower(key.Value)
+
+			if value.Kind == yaml.MappingNode && len(value.Content) > 0 {
+				walk(value, dotted+".")
+				continue
+			}
+
+			lines[dotted] = key.Line
+		}
+	}
+
+	if root := doc.Content[0]; root.Kind == yaml.MappingNode {
+		walk(root, "")
+	}
+
+	return lines
+}
+
+// tomlKeyLines returns the lines of the key/value pairs and of the arrays of tables of a TOML document, by dotted key.
+func tomlKeyLines(data []byte) map[string]int {
+	lines := map[string]int{}
+
+	var table string
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case strings.HasPrefix(line, "[["):
+			table = tomlKey(strings.Trim(line[:strings.Index(line+"]]", "]]")], "[ "))
+			lines[table] = n
+
+		case strings.HasPrefix(line, "["):
+			table = tomlKey(strings.Trim(line[:strings.Index(line+"]", "]")], "[ "))
+
+		default:
+			key, _, ok := strings.Cut(line, "=")
+			if !ok || strings.HasPrefix(line, "#") {
+				continue
+			}
+
+			key = tomlKey(key)
+			if table != "" {
+				key = table + "." + key
+			}
+
+			if _, found := lines[key]; !found {
+				lines[key] = n
+			}
+		}
+	}
+
+	return lines
+}
+
+func tomlKey(key string) string {
+	parts := strings.Split(key, ".")
+	for i, part := range parts {
+		parts[i] = strings.ToLower(strings.Trim(strings.TrimSpace(part), `"'`))
+	}
+
+	return strings.Join(parts, ".")
+}
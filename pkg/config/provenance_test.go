@@ -0,0 +1,135 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+)
+
+func TestProvenance_Source(t *testing.T) {
+	p := NewProvenance()
+	p.Set("run.timeout", Source{Kind: SourceFlag, Name: "timeout"})
+	p.Set("issues.exclude-rules", Source{Kind: SourceFile, Name: ".golangci.yml", Line: 3})
+
+	assert.Equal(t, "flag --timeout", p.Source("run.timeout").String())
+	assert.Equal(t, ".golangci.yml:3", p.Source("issues.exclude-rules").String())
+	assert.Equal(t, ".golangci.yml:3", p.Source("issues.exclude-rules.path").String())
+	assert.Equal(t, "default", p.Source("run.tests").String())
+
+	p.copy("run.go", "run.timeout")
+	assert.Equal(t, "flag --timeout", p.Source("run.go").String())
+
+	p.reset("run")
+	assert.Equal(t, "default", p.Source("run.timeout").String())
+	assert.Equal(t, ".golangci.yml:3", p.Source("issues.exclude-rules").String())
+}
+
+func Test_yamlKeyLines(t *testing.T) {
+	data := []byte(`
+run:
+  timeout: 5m
+linters:
+  enable:
+    - errcheck
+  Disable-All: true
+linters-settings:
+  funlen: {}
+  lll:
+    line-length: 120
+`)
+
+	expected := map[string]int{
+		"run.timeout":                      3,
+		"linters.enable":                   5,
+		"linters.disable-all":              7,
+		"linters-settings.funlen":          9,
+		"linters-settings.lll.line-length": 11,
+	}
+
+	assert.Equal(t, expected, yamlKeyLines(data))
+}
+
+func Test_yamlKeyLines_json(t *testing.T) {
+	data := []byte(`{
+  "run": {
+    "timeout": "5m"
+  }
+}`)
+
+	assert.Equal(t, map[string]int{"run.timeout": 3}, yamlKeyLines(data))
+}
+
+func Test_tomlKeyLines(t *testing.T) {
+	data := []byte(`
+[run]
+timeout = "5m" # comment
+
+[linters]
+enable = [
+  "errcheck",
+]
+
+[[issues.exclude-rules]]
+path = "_test.go"
+
+[linters-settings.lll]
+"line-length" = 120
+`)
+
+	expected := map[string]int{
+		"run.timeout":                      3,
+		"linters.enable":                   6,
+		"issues.exclude-rules":             10,
+		"issues.exclude-rules.path":        11,
+		"linters-settings.lll.line-length": 14,
+	}
+
+	assert.Equal(t, expected, tomlKeyLines(data))
+}
+
+func TestLoader_Provenance(t *testing.T) {
+	file := filepath.Join(t.TempDir(), ".golangci.yml")
+
+	require.NoError(t, os.WriteFile(file, []byte(`
+run:
+  timeout: 5m
+  tests: false
+linters:
+  enable: [errcheck]
+`), 0o600))
+
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	fs.StringSlice("enable", nil, "")
+	fs.Bool("tests", true, "")
+	require.NoError(t, fs.SetAnnotation("tests", BindingAnnotation, []string{"run.tests"}))
+
+	v := viper.New()
+	require.NoError(t, v.BindPFlag("run.tests", fs.Lookup("tests")))
+
+	require.NoError(t, fs.Parse([]string{"--enable", "govet", "--tests"}))
+
+	cfg := NewDefault()
+
+	loader := NewLoader(logutils.NewStderrLog("skip"), v, fs, LoaderOptions{Config: file}, cfg, nil)
+	require.NoError(t, loader.Load(LoadOptions{}))
+
+	provenance := loader.Provenance()
+
+	source := provenance.Source("run.timeout")
+	assert.Equal(t, SourceFile, source.Kind)
+	assert.Equal(t, ".golangci.yml", filepath.Base(source.Name))
+	assert.Equal(t, 3, source.Line)
+
+	assert.Equal(t, "flag --tests", provenance.Source("run.tests").String())
+	assert.Equal(t, "flag --enable", provenance.Source("linters.enable").String())
+	assert.Equal(t, "default", provenance.Source("issues.max-same-issues").String())
+
+	assert.Equal(t, []string{"errcheck", "govet"}, cfg.Linters.Enable)
+}
@@ -21,6 +21,10 @@ type Run struct {
 	ExitCodeIfIssuesFound int  `mapstructure:"issues-exit-code"`
 	AnalyzeTests          bool `mapstructure:"tests"`
 
+	MultiModule bool `mapstructure:"multi-module"`
+
+	NestedConfigs bool `mapstructure:"nested-configs"`
+
 	AllowParallelRunners bool `mapstructure:"allow-parallel-runners"`
 	AllowSerialRunners   bool `mapstructure:"allow-serial-runners"`
 
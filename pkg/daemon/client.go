@@ -0,0 +1,59 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"github.com/snowmerak/golangci-lint/pkg/exitcodes"
+)
+
+// Run sends a request to the daemon listening on the socket, and reads its responses.
+func Run(ctx context.Context, socket string, req Request) (*Result, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "unix", socket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the daemon: %w", err)
+	}
+
+	defer func() { _ = conn.Close() }()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	if err = json.NewEncoder(conn).Encode(req); err != nil {
+		return nil, fmt.Errorf("failed to send the request to the daemon: %w", err)
+	}
+
+	res := &Result{}
+
+	dec := json.NewDecoder(conn)
+
+	for {
+		var resp Response
+		if err := dec.Decode(&resp); err != nil {
+			return nil, fmt.Errorf("failed to read the response of the daemon: %w", err)
+		}
+
+		if resp.Issue != nil {
+			res.Issues = append(res.Issues, *resp.Issue)
+			continue
+		}
+
+		if resp.Summary == nil {
+			continue
+		}
+
+		if resp.Summary.Failure != "" {
+			return nil, &exitcodes.ExitError{Message: resp.Summary.Failure, Code: resp.Summary.ExitCode}
+		}
+
+		res.Report.Warnings = resp.Summary.Warnings
+		res.Report.Error = resp.Summary.Error
+		res.Report.Suppressed = resp.Summary.Suppressed
+
+		return res, nil
+	}
+}
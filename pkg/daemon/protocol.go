@@ -0,0 +1,47 @@
+// Package daemon implements the communication between the golangci-lint daemon and its clients.
+//
+// The messages are JSON values sent over a Unix socket:
+// the client sends a Request, the daemon answers with a stream of Response,
+// one for each issue, and a last one with the summary of the run.
+package daemon
+
+import (
+	"github.com/snowmerak/golangci-lint/pkg/report"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+// Request asks the daemon to lint packages.
+type Request struct {
+	// WorkDir is the working directory of the client: it must be the one of the daemon.
+	WorkDir string
+
+	// Args are the packages to lint, as the arguments of the `run` command.
+	Args []string
+}
+
+// Response is a message of the daemon: an issue, or the summary ending the stream.
+type Response struct {
+	Issue   *result.Issue `json:",omitempty"`
+	Summary *Summary      `json:",omitempty"`
+}
+
+// Summary ends the responses to a request.
+type Summary struct {
+	Warnings []report.Warning `json:",omitempty"`
+
+	// Error is the error logged by the daemon during the run.
+	Error string `json:",omitempty"`
+
+	// Suppressed contains the issues suppressed by nolint directives and by the suppressions file (see `output.print-suppressed`).
+	Suppressed []result.Issue `json:",omitempty"`
+
+	// Failure is the error that stopped the run, with its exit code.
+	Failure  string `json:",omitempty"`
+	ExitCode int    `json:",omitempty"`
+}
+
+// Result is the result of a run of the daemon.
+type Result struct {
+	Issues []result.Issue
+	Report report.Data
+}
@@ -0,0 +1,148 @@
+package daemon
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/snowmerak/golangci-lint/pkg/exitcodes"
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+)
+
+// Handler lints the packages matching the arguments.
+type Handler func(ctx context.Context, args []string) (*Result, error)
+
+// Server answers the requests of the clients.
+// The requests are handled one at a time: they share the caches of the daemon.
+type Server struct {
+	log     logutils.Log
+	workDir string
+	handler Handler
+
+	mu sync.Mutex
+}
+
+func NewServer(log logutils.Log, workDir string, handler Handler) *Server {
+	return &Server{
+		log:     log,
+		workDir: workDir,
+		handler: handler,
+	}
+}
+
+// Serve accepts the connections until the context is canceled.
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		_ = listener.Close()
+	}()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("failed to accept connection: %w", err)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			s.handle(ctx, conn)
+		}()
+	}
+}
+
+func (s *Server) handle(ctx context.Context, conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+
+	enc := json.NewEncoder(conn)
+
+	err := s.answer(ctx, conn, enc)
+	if err == nil {
+		return
+	}
+
+	s.log.Warnf("Request failed: %v", err)
+
+	summary := &Summary{Failure: err.Error(), ExitCode: exitcodes.Failure}
+
+	var exitErr *exitcodes.ExitError
+	if errors.As(err, &exitErr) {
+		summary.ExitCode = exitErr.Code
+	}
+
+	if err = enc.Encode(Response{Summary: summary}); err != nil {
+		s.log.Warnf("Failed to send the response: %v", err)
+	}
+}
+
+func (s *Server) answer(ctx context.Context, conn net.Conn, enc *json.Encoder) error {
+	var req Request
+	if err := json.NewDecoder(conn).Decode(&req); err != nil {
+		return fmt.Errorf("invalid request: %w", err)
+	}
+
+	if req.WorkDir != s.workDir {
+		return fmt.Errorf("the daemon runs in %s, not in %s", s.workDir, req.WorkDir)
+	}
+
+	s.log.Infof("Request for %v", req.Args)
+
+	s.mu.Lock()
+	res, err := s.handler(ctx, req.Args)
+	s.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	for i := range res.Issues {
+		if err := enc.Encode(Response{Issue: &res.Issues[i]}); err != nil {
+			return err
+		}
+	}
+
+	return enc.Encode(Response{Summary: &Summary{
+		Warnings:   res.Report.Warnings,
+		Error:      res.Report.Error,
+		Suppressed: res.Report.Suppressed,
+	}})
+}
+
+// Listen listens on the socket.
+// A socket file left by a daemon that is not running anymore is removed.
+func Listen(socket string) (net.Listener, error) {
+	if _, err := os.Stat(socket); err == nil {
+		conn, err := net.Dial("unix", socket)
+		if err == nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("a daemon is already listening on %s", socket)
+		}
+
+		if err := os.Remove(socket); err != nil {
+			return nil, fmt.Errorf("failed to remove stale socket: %w", err)
+		}
+	}
+
+	return net.Listen("unix", socket)
+}
+
+// DefaultSocket returns the socket of the daemon running in a directory.
+func DefaultSocket(workDir string) string {
+	sum := sha256.Sum256([]byte(workDir))
+
+	return filepath.Join(os.TempDir(), fmt.Sprintf("golangci-lint-%x.sock", sum[:6]))
+}
@@ -0,0 +1,109 @@
+package daemon
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/snowmerak/golangci-lint/pkg/exitcodes"
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+	"github.com/snowmerak/golangci-lint/pkg/report"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+func TestRun(t *testing.T) {
+	expected := &Result{
+		Issues: []result.Issue{
+			{FromLinter: "linter-a", Text: "some issue", Severity: "error"},
+			{FromLinter: "linter-b", Text: "another issue", RuleID: "B001"},
+		},
+		Report: report.Data{
+			Warnings:   []report.Warning{{Tag: "runner", Text: "some warning"}},
+			Suppressed: []result.Issue{{FromLinter: "linter-a", Suppression: &result.Suppression{Justification: "false positive"}}},
+		},
+	}
+
+	var receivedArgs []string
+
+	socket := startServer(t, "/work", func(_ context.Context, args []string) (*Result, error) {
+		receivedArgs = args
+		return expected, nil
+	})
+
+	res, err := Run(context.Background(), socket, Request{WorkDir: "/work", Args: []string{"./..."}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"./..."}, receivedArgs)
+	assert.Equal(t, expected.Issues, res.Issues)
+	assert.Equal(t, expected.Report.Warnings, res.Report.Warnings)
+	assert.Equal(t, expected.Report.Suppressed, res.Report.Suppressed)
+}
+
+func TestRun_workDirMismatch(t *testing.T) {
+	socket := startServer(t, "/work", func(_ context.Context, _ []string) (*Result, error) {
+		return &Result{}, nil
+	})
+
+	_, err := Run(context.Background(), socket, Request{WorkDir: "/other"})
+
+	var exitErr *exitcodes.ExitError
+	require.ErrorAs(t, err, &exitErr)
+
+	assert.Equal(t, exitcodes.Failure, exitErr.Code)
+	assert.Equal(t, "the daemon runs in /work, not in /other", exitErr.Message)
+}
+
+func TestRun_failure(t *testing.T) {
+	socket := startServer(t, "/work", func(_ context.Context, _ []string) (*Result, error) {
+		return nil, &exitcodes.ExitError{Message: "no go files to analyze", Code: exitcodes.NoGoFiles}
+	})
+
+	_, err := Run(context.Background(), socket, Request{WorkDir: "/work"})
+
+	var exitErr *exitcodes.ExitError
+	require.ErrorAs(t, err, &exitErr)
+
+	assert.Equal(t, exitcodes.NoGoFiles, exitErr.Code)
+}
+
+func TestListen_alreadyListening(t *testing.T) {
+	socket := startServer(t, "/work", func(_ context.Context, _ []string) (*Result, error) {
+		return &Result{}, nil
+	})
+
+	_, err := Listen(socket)
+	require.Error(t, err)
+}
+
+func startServer(t *testing.T, workDir string, handler Handler) string {
+	t.Helper()
+
+	// The paths of the Unix sockets are limited to ~100 characters: t.TempDir() can be too long.
+	dir, err := os.MkdirTemp("", "gl")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	socket := filepath.Join(dir, "d.sock")
+
+	listener, err := Listen(socket)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error)
+	go func() {
+		done <- NewServer(logutils.NewStderrLog("skip"), workDir, handler).Serve(ctx, listener)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+
+	return socket
+}
@@ -31,6 +31,11 @@ func (fc *FileCache) GetFileBytes(filePath string) ([]byte, error) {
 	return fileBytes, nil
 }
 
+// SetFileBytes replaces the content of a file, ex: by the content of an unsaved editor buffer.
+func (fc *FileCache) SetFileBytes(filePath string, fileBytes []byte) {
+	fc.files.Store(filePath, fileBytes)
+}
+
 func PrettifyBytesCount(n int64) string {
 	const (
 		Multiplexer = 1024
@@ -0,0 +1,158 @@
+package fsutils
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+)
+
+// Watcher reports the changes of the files of directory trees.
+// The changes are debounced: a batch of changes is reported when no change happened during the delay.
+type Watcher struct {
+	log   logutils.Log
+	fsw   *fsnotify.Watcher
+	delay time.Duration
+
+	// match reports whether the changes of a file are reported.
+	match func(path string) bool
+
+	// skipDir reports whether a directory is not watched.
+	skipDir func(dir string) bool
+}
+
+// NewWatcher creates a watcher.
+// The hidden directories (ex: .git) and the directories matched by skipDir are not watched.
+func NewWatcher(log logutils.Log, delay time.Duration, match func(path string) bool, skipDir func(dir string) bool) (*Watcher, error) {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create watcher: %w", err)
+	}
+
+	return &Watcher{
+		log:     log,
+		fsw:     fsw,
+		delay:   delay,
+		match:   match,
+		skipDir: skipDir,
+	}, nil
+}
+
+// AddTree watches the directory and its subdirectories.
+func (w *Watcher) AddTree(root string) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.IsDir() {
+			return nil
+		}
+
+		if path != root && (strings.HasPrefix(d.Name(), ".") || w.skipDir(path)) {
+			return filepath.SkipDir
+		}
+
+		if err := w.fsw.Add(path); err != nil {
+			return fmt.Errorf("failed to watch %s: %w", path, err)
+		}
+
+		return nil
+	})
+}
+
+// AddDir watches the directory without its subdirectories (ex: the directory of a configuration file).
+func (w *Watcher) AddDir(dir string) error {
+	if err := w.fsw.Add(dir); err != nil {
+		return fmt.Errorf("failed to watch %s: %w", dir, err)
+	}
+
+	return nil
+}
+
+// Next waits for the next batch of changes, and returns the sorted changed paths.
+func (w *Watcher) Next(ctx context.Context) ([]string, error) {
+	changes := map[string]bool{}
+
+	// The timer is started by the first change.
+	var timer <-chan time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+
+		case err := <-w.fsw.Errors:
+			w.log.Warnf("Watcher error: %v", err)
+
+		case event := <-w.fsw.Events:
+			paths := w.handle(event)
+			if len(paths) == 0 {
+				continue
+			}
+
+			for _, path := range paths {
+				changes[path] = true
+			}
+
+			timer = time.After(w.delay)
+
+		case <-timer:
+			paths := make([]string, 0, len(changes))
+			for path := range changes {
+				paths = append(paths, path)
+			}
+
+			sort.Strings(paths)
+
+			return paths, nil
+		}
+	}
+}
+
+// handle returns the changed files matched by the event.
+// The new directories are watched: their files are reported as changed.
+func (w *Watcher) handle(event fsnotify.Event) []string {
+	if event.Op == fsnotify.Chmod {
+		return nil
+	}
+
+	if event.Op&fsnotify.Create == 0 || !IsDir(event.Name) {
+		if w.match(event.Name) {
+			return []string{event.Name}
+		}
+
+		return nil
+	}
+
+	if strings.HasPrefix(filepath.Base(event.Name), ".") || w.skipDir(event.Name) {
+		return nil
+	}
+
+	if err := w.AddTree(event.Name); err != nil {
+		w.log.Warnf("Failed to watch the new directory: %v", err)
+	}
+
+	// The files can have been created before the directory is watched.
+	var paths []string
+	_ = filepath.WalkDir(event.Name, func(path string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() && w.match(path) {
+			paths = append(paths, path)
+		}
+
+		return nil
+	})
+
+	return paths
+}
+
+func (w *Watcher) Close() error {
+	return w.fsw.Close()
+}
@@ -0,0 +1,52 @@
+package fsutils
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+)
+
+func TestWatcher_Next(t *testing.T) {
+	root := t.TempDir()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "vendor"), 0o755))
+
+	match := func(path string) bool { return strings.HasSuffix(path, ".go") }
+	skipDir := func(dir string) bool { return filepath.Base(dir) == "vendor" }
+
+	w, err := NewWatcher(logutils.NewStderrLog("skip"), 50*time.Millisecond, match, skipDir)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = w.Close() })
+
+	require.NoError(t, w.AddTree(root))
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, "a.go"), []byte("package a\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "a.txt"), []byte("a\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "vendor", "v.go"), []byte("package v\n"), 0o600))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	changes, err := w.Next(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{filepath.Join(root, "a.go")}, changes)
+
+	// The files of the new directories are reported.
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "b"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "b", "b.go"), []byte("package b\n"), 0o600))
+
+	changes, err = w.Next(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{filepath.Join(root, "b", "b.go")}, changes)
+}
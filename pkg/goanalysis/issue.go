@@ -23,6 +23,7 @@ func NewIssue(issue *result.Issue, pass *analysis.Pass) Issue {
 type EncodingIssue struct {
 	FromLinter           string
 	Text                 string
+	RuleID               string
 	Severity             string
 	Pos                  token.Position
 	LineRange            *result.Range
@@ -58,10 +58,11 @@ type runner struct {
 	passToPkg      map[*analysis.Pass]*packages.Package
 	passToPkgGuard sync.Mutex
 	sw             *timeutils.Stopwatch
+	overlay        map[string][]byte
 }
 
 func newRunner(prefix string, logger logutils.Log, pkgCache *pkgcache.Cache, loadGuard *load.Guard,
-	loadMode LoadMode, sw *timeutils.Stopwatch,
+	loadMode LoadMode, sw *timeutils.Stopwatch, overlay map[string][]byte,
 ) *runner {
 	return &runner{
 		prefix:    prefix,
@@ -71,6 +72,7 @@ func newRunner(prefix string, logger logutils.Log, pkgCache *pkgcache.Cache, loa
 		loadMode:  loadMode,
 		passToPkg: map[*analysis.Pass]*packages.Package{},
 		sw:        sw,
+		overlay:   overlay,
 	}
 }
 
@@ -252,6 +254,7 @@ func (r *runner) analyze(pkgs []*packages.Package, analyzers []*analysis.Analyze
 			log:        r.log,
 			actions:    actionPerPkg[pkg],
 			loadGuard:  r.loadGuard,
+			overlay:    r.overlay,
 			dependents: 1, // self dependent
 		}
 	}
@@ -28,6 +28,7 @@ type loadingPackage struct {
 	log         logutils.Log
 	actions     []*action // all actions with this package
 	loadGuard   *load.Guard
+	overlay     map[string][]byte
 	dependents  int32 // number of depending on it packages
 	analyzeOnce sync.Once
 	decUseMutex sync.Mutex
@@ -93,7 +94,12 @@ func (lp *loadingPackage) loadFromSource(loadMode LoadMode) error {
 	// bookkeeping and potentially false sharing of cache lines.
 	pkg.Syntax = make([]*ast.File, 0, len(pkg.CompiledGoFiles))
 	for _, file := range pkg.CompiledGoFiles {
-		f, err := parser.ParseFile(pkg.Fset, file, nil, parser.ParseComments)
+		var src any
+		if content, ok := lp.overlay[file]; ok {
+			src = content
+		}
+
+		f, err := parser.ParseFile(pkg.Fset, file, src, parser.ParseComments)
 		if err != nil {
 			pkg.Errors = append(pkg.Errors, lp.convertError(err)...)
 			continue
@@ -36,7 +36,7 @@ func runAnalyzers(cfg runAnalyzersConfig, lintCtx *linter.Context) ([]result.Iss
 	const stagesToPrint = 10
 	defer sw.PrintTopStages(stagesToPrint)
 
-	runner := newRunner(cfg.getName(), log, lintCtx.PkgCache, lintCtx.LoadGuard, cfg.getLoadMode(), sw)
+	runner := newRunner(cfg.getName(), log, lintCtx.PkgCache, lintCtx.LoadGuard, cfg.getLoadMode(), sw, lintCtx.Overlay)
 
 	pkgs := lintCtx.Packages
 	if cfg.useOriginalPackages() {
@@ -71,7 +71,7 @@ func runAnalyzers(cfg runAnalyzersConfig, lintCtx *linter.Context) ([]result.Iss
 			}
 			retIssues = append(retIssues, *issue)
 		}
-		retIssues = append(retIssues, buildIssues(diags, cfg.getLinterNameForDiagnostic)...)
+		retIssues = append(retIssues, buildIssues(diags, cfg.getLinterNameForDiagnostic, log)...)
 		return retIssues
 	}
 
@@ -86,31 +86,44 @@ func runAnalyzers(cfg runAnalyzersConfig, lintCtx *linter.Context) ([]result.Iss
 	return issues, nil
 }
 
-func buildIssues(diags []Diagnostic, linterNameBuilder func(diag *Diagnostic) string) []result.Issue {
+func buildIssues(diags []Diagnostic, linterNameBuilder func(diag *Diagnostic) string, log logutils.Log) []result.Issue {
 	var issues []result.Issue
 	for i := range diags {
 		diag := &diags[i]
 		linterName := linterNameBuilder(diag)
 
-		var text string
+		// The analyzers of a linter with several analyzers are its rules (ex: staticcheck, govet).
+		// The categories of the diagnostics aren't used: they aren't stable identifiers for most analyzers.
+		var text, ruleID string
 		if diag.Analyzer.Name == linterName {
 			text = diag.Message
 		} else {
 			text = fmt.Sprintf("%s: %s", diag.Analyzer.Name, diag.Message)
+			ruleID = diag.Analyzer.Name
 		}
 
-		issues = append(issues, result.Issue{
+		issue := result.Issue{
 			FromLinter: linterName,
 			Text:       text,
+			RuleID:     ruleID,
 			Pos:        diag.Position,
 			Pkg:        diag.Pkg,
-		})
+		}
+
+		replacement, err := buildReplacement(diag)
+		if err != nil {
+			log.Infof("Can't use the suggested fix of %s at %s: %v", diag.Analyzer.Name, diag.Position, err)
+		}
+		issue.Replacement = replacement
+
+		issues = append(issues, issue)
 
 		if len(diag.Related) > 0 {
 			for _, info := range diag.Related {
 				issues = append(issues, result.Issue{
 					FromLinter: linterName,
 					Text:       fmt.Sprintf("%s(related information): %s", diag.Analyzer.Name, info.Message),
+					RuleID:     ruleID,
 					Pos:        diag.Pkg.Fset.Position(info.Pos),
 					Pkg:        diag.Pkg,
 				})
@@ -153,6 +166,7 @@ func saveIssuesToCache(allPkgs []*packages.Package, pkgsFromCache map[*packages.
 					encodedIssues = append(encodedIssues, EncodingIssue{
 						FromLinter:           i.FromLinter,
 						Text:                 i.Text,
+						RuleID:               i.RuleID,
 						Severity:             i.Severity,
 						Pos:                  i.Pos,
 						LineRange:            i.LineRange,
@@ -226,6 +240,7 @@ func loadIssuesFromCache(pkgs []*packages.Package, lintCtx *linter.Context,
 					issues = append(issues, result.Issue{
 						FromLinter:           issue.FromLinter,
 						Text:                 issue.Text,
+						RuleID:               issue.RuleID,
 						Severity:             issue.Severity,
 						Pos:                  issue.Pos,
 						LineRange:            issue.LineRange,
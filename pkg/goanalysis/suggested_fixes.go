@@ -0,0 +1,56 @@
+package goanalysis
+
+import (
+	"fmt"
+	"go/token"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+// buildReplacement converts the first suggested fix of the diagnostic into a replacement.
+// The analyzers can provide several fixes, but they are alternatives: only one of them can be applied.
+// All the edits must be inside the file of the diagnostic.
+func buildReplacement(diag *Diagnostic) (*result.Replacement, error) {
+	if len(diag.SuggestedFixes) == 0 || len(diag.SuggestedFixes[0].TextEdits) == 0 {
+		return nil, nil
+	}
+
+	edits, err := toTextEdits(diag.Pkg.Fset, diag.Position.Filename, diag.SuggestedFixes[0].TextEdits)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.Replacement{TextEdits: edits}, nil
+}
+
+func toTextEdits(fset *token.FileSet, filename string, edits []analysis.TextEdit) ([]result.TextEdit, error) {
+	res := make([]result.TextEdit, 0, len(edits))
+
+	for _, edit := range edits {
+		end := edit.End
+		if !end.IsValid() {
+			end = edit.Pos
+		}
+
+		// The fixes are applied to the physical file: the `//line` directives must be ignored.
+		start, stop := fset.PositionFor(edit.Pos, false), fset.PositionFor(end, false)
+
+		if start.Filename != filename || stop.Filename != filename {
+			return nil, fmt.Errorf("edit is outside of the file %s", filename)
+		}
+
+		if stop.Offset < start.Offset {
+			return nil, fmt.Errorf("invalid edit: end (%d) is before start (%d)", stop.Offset, start.Offset)
+		}
+
+		res = append(res, result.TextEdit{
+			Pos:     start.Offset,
+			End:     stop.Offset,
+			NewText: string(edit.NewText),
+		})
+	}
+
+	return res, nil
+}
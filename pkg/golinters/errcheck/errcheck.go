@@ -24,6 +24,9 @@ import (
 
 const linterName = "errcheck"
 
+// errcheck has only one check.
+const ruleUncheckedError = "unchecked-error"
+
 func New(settings *config.ErrcheckSettings) *goanalysis.Linter {
 	var mu sync.Mutex
 	var resIssues []goanalysis.Issue
@@ -102,6 +105,7 @@ func runErrCheck(lintCtx *linter.Context, pass *analysis.Pass, checker *errcheck
 			&result.Issue{
 				FromLinter: linterName,
 				Text:       text,
+				RuleID:     ruleUncheckedError,
 				Pos:        err.Pos,
 			},
 			pass,
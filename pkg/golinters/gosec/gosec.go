@@ -125,6 +125,7 @@ func runGoSec(lintCtx *linter.Context, pass *analysis.Pass, settings *config.GoS
 				Column:   column,
 			},
 			Text:       text,
+			RuleID:     i.RuleID,
 			LineRange:  r,
 			FromLinter: linterName,
 		}, pass))
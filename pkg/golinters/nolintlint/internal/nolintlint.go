@@ -7,9 +7,11 @@ import (
 	"go/token"
 	"regexp"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/snowmerak/golangci-lint/pkg/result"
+	"github.com/snowmerak/golangci-lint/pkg/timeutils"
 )
 
 type BaseIssue struct {
@@ -107,6 +109,45 @@ func (i UnusedCandidate) Details() string {
 
 func (i UnusedCandidate) String() string { return toString(i) }
 
+type InvalidAttribute struct {
+	BaseIssue
+	attribute string
+}
+
+//nolint:gocritic // TODO(ldez) must be change in the future.
+func (i InvalidAttribute) Details() string {
+	return fmt.Sprintf("directive `%s` has an invalid attribute `%s`: expected `until=YYYY-MM-DD` or `issue=<reference>`",
+		i.fullDirective, i.attribute)
+}
+
+func (i InvalidAttribute) String() string { return toString(i) }
+
+type Expired struct {
+	BaseIssue
+	until string
+}
+
+//nolint:gocritic // TODO(ldez) must be change in the future.
+func (i Expired) Details() string {
+	return fmt.Sprintf("directive `%s` expired on %s", i.fullDirective, i.until)
+}
+
+func (i Expired) String() string { return toString(i) }
+
+type NoIssue struct {
+	BaseIssue
+	directiveWithLinters string
+	pattern              string
+}
+
+//nolint:gocritic // TODO(ldez) must be change in the future.
+func (i NoIssue) Details() string {
+	return fmt.Sprintf("directive `%s` should reference an issue matching `%s` such as `%s issue=<reference>`",
+		i.fullDirective, i.pattern, i.directiveWithLinters)
+}
+
+func (i NoIssue) String() string { return toString(i) }
+
 func toString(issue Issue) string {
 	return fmt.Sprintf("%s at %s", issue.Details(), issue.Position())
 }
@@ -130,25 +171,48 @@ const (
 
 var commentPattern = regexp.MustCompile(`^//\s*(nolint)(:\s*[\w-]+\s*(?:,\s*[\w-]+\s*)*)?\b`)
 
-// matches a complete nolint directive
-var fullDirectivePattern = regexp.MustCompile(`^//\s*nolint(?::(\s*[\w-]+\s*(?:,\s*[\w-]+\s*)*))?\s*(//.*)?\s*\n?$`)
+// matches a complete nolint directive, with its optional attributes (ex: `until=2026-12-31 issue=PROJ-123`)
+var fullDirectivePattern = regexp.MustCompile(
+	`^//\s*nolint(?::(\s*[\w-]+\s*(?:,\s*[\w-]+\s*)*))?((?:\s+[\w-]+=\S*)*)\s*(//.*)?\s*\n?$`)
+
+const (
+	attributeUntil = "until"
+	attributeIssue = "issue"
+)
+
+const dateLayout = time.DateOnly
 
 type Linter struct {
 	needs           Needs // indicates which linter checks to perform
 	excludeByLinter map[string]bool
+	issuePattern    *regexp.Regexp // the pattern of the issue references required by the directives
+	today           string         // the current date, to detect the expired directives
 }
 
-// NewLinter creates a linter that enforces that the provided directives fulfill the provided requirements
-func NewLinter(needs Needs, excludes []string) (*Linter, error) {
+// NewLinter creates a linter that enforces that the provided directives fulfill the provided requirements.
+// If the issue pattern is not empty, the directives must reference an issue matching the pattern (`issue=<reference>`).
+func NewLinter(needs Needs, excludes []string, issuePattern string) (*Linter, error) {
 	excludeByName := make(map[string]bool)
 	for _, e := range excludes {
 		excludeByName[e] = true
 	}
 
-	return &Linter{
+	l := &Linter{
 		needs:           needs | NeedsMachineOnly,
 		excludeByLinter: excludeByName,
-	}, nil
+		today:           time.Now().Format(dateLayout),
+	}
+
+	if issuePattern != "" {
+		var err error
+
+		l.issuePattern, err = regexp.Compile(issuePattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid issue pattern %q: %w", issuePattern, err)
+		}
+	}
+
+	return l, nil
 }
 
 var (
@@ -224,7 +288,14 @@ func (l Linter) Run(fset *token.FileSet, nodes ...ast.Node) ([]Issue, error) {
 					continue
 				}
 
-				lintersText, explanation := fullMatches[1], fullMatches[2]
+				lintersText, attributesText, explanation := fullMatches[1], fullMatches[2], fullMatches[3]
+
+				directiveWithLinters := directiveWithOptionalLeadingSpace
+				if lintersText != "" {
+					directiveWithLinters += ":" + strings.TrimSpace(lintersText)
+				}
+
+				issues = append(issues, l.checkAttributes(base, directiveWithLinters, strings.Fields(attributesText))...)
 
 				var linters []string
 				if lintersText != "" && !strings.HasPrefix(lintersText, "all") {
@@ -302,3 +373,47 @@ func (l Linter) Run(fset *token.FileSet, nodes ...ast.Node) ([]Issue, error) {
 
 	return issues, nil
 }
+
+// checkAttributes checks the attributes of a directive: the expiry date and the issue reference.
+func (l Linter) checkAttributes(base BaseIssue, directiveWithLinters string, attributes []string) []Issue {
+	var issues []Issue
+
+	var issue string
+
+	for _, attribute := range attributes {
+		key, value, _ := strings.Cut(attribute, "=")
+
+		switch key {
+		case attributeUntil:
+			if _, err := time.Parse(dateLayout, value); err != nil {
+				issues = append(issues, InvalidAttribute{BaseIssue: base, attribute: attribute})
+				continue
+			}
+
+			if timeutils.Expired(value, l.today) {
+				issues = append(issues, Expired{BaseIssue: base, until: value})
+			}
+
+		case attributeIssue:
+			if value == "" {
+				issues = append(issues, InvalidAttribute{BaseIssue: base, attribute: attribute})
+				continue
+			}
+
+			issue = value
+
+		default:
+			issues = append(issues, InvalidAttribute{BaseIssue: base, attribute: attribute})
+		}
+	}
+
+	if l.issuePattern != nil && !l.issuePattern.MatchString(issue) {
+		issues = append(issues, NoIssue{
+			BaseIssue:            base,
+			directiveWithLinters: directiveWithLinters,
+			pattern:              l.issuePattern.String(),
+		})
+	}
+
+	return issues
+}
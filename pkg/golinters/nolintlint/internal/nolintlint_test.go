@@ -17,11 +17,12 @@ func TestLinter_Run(t *testing.T) {
 		replacement *result.Replacement
 	}
 	testCases := []struct {
-		desc     string
-		needs    Needs
-		excludes []string
-		contents string
-		expected []issueWithReplacement
+		desc         string
+		needs        Needs
+		excludes     []string
+		issuePattern string
+		contents     string
+		expected     []issueWithReplacement
 	}{
 		{
 			desc:  "when no explanation is provided",
@@ -206,6 +207,43 @@ func foo() {
 				},
 			},
 		},
+		{
+			desc: "when the directive has attributes",
+			contents: `
+package bar
+
+func foo() {
+  bad() //nolint:errcheck until=2024-05-31 // expired
+  bad() //nolint:errcheck until=2024-06-01 issue=PROJ-1 // not expired
+  bad() //nolint until=31/05/2024
+  bad() //nolint:errcheck owner=me
+}`,
+			expected: []issueWithReplacement{
+				{issue: "directive `//nolint:errcheck until=2024-05-31 // expired` expired on 2024-05-31 at testing.go:5:9"},
+				{issue: "directive `//nolint until=31/05/2024` has an invalid attribute `until=31/05/2024`: " +
+					"expected `until=YYYY-MM-DD` or `issue=<reference>` at testing.go:7:9"},
+				{issue: "directive `//nolint:errcheck owner=me` has an invalid attribute `owner=me`: " +
+					"expected `until=YYYY-MM-DD` or `issue=<reference>` at testing.go:8:9"},
+			},
+		},
+		{
+			desc:         "when an issue reference is required",
+			issuePattern: `^PROJ-\d+$`,
+			contents: `
+package bar
+
+func foo() {
+  good() //nolint:errcheck issue=PROJ-123 // this is ok
+  bad() //nolint:errcheck // no issue
+  bad() //nolint:errcheck issue=OTHER-1
+}`,
+			expected: []issueWithReplacement{
+				{issue: "directive `//nolint:errcheck // no issue` should reference an issue matching `^PROJ-\\d+$` " +
+					"such as `//nolint:errcheck issue=<reference>` at testing.go:6:9"},
+				{issue: "directive `//nolint:errcheck issue=OTHER-1` should reference an issue matching `^PROJ-\\d+$` " +
+					"such as `//nolint:errcheck issue=<reference>` at testing.go:7:9"},
+			},
+		},
 	}
 
 	for _, test := range testCases {
@@ -213,7 +251,10 @@ func foo() {
 		t.Run(test.desc, func(t *testing.T) {
 			t.Parallel()
 
-			linter, _ := NewLinter(test.needs, test.excludes)
+			linter, err := NewLinter(test.needs, test.excludes, test.issuePattern)
+			require.NoError(t, err)
+
+			linter.today = "2024-06-01"
 
 			fset := token.NewFileSet()
 			expr, err := parser.ParseFile(fset, "testing.go", test.contents, parser.ParseComments)
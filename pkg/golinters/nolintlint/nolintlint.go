@@ -63,7 +63,7 @@ func runNoLintLint(pass *analysis.Pass, settings *config.NoLintLintSettings) ([]
 		needs |= internal.NeedsUnused
 	}
 
-	lnt, err := internal.NewLinter(needs, settings.AllowNoExplanation)
+	lnt, err := internal.NewLinter(needs, settings.AllowNoExplanation, settings.RequireIssuePattern)
 	if err != nil {
 		return nil, err
 	}
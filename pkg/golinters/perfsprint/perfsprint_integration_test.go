@@ -9,3 +9,11 @@ import (
 func TestFromTestdata(t *testing.T) {
 	integration.RunTestdata(t)
 }
+
+func TestFix(t *testing.T) {
+	integration.RunFix(t)
+}
+
+func TestFixPathPrefix(t *testing.T) {
+	integration.RunFixPathPrefix(t)
+}
@@ -0,0 +1,25 @@
+//golangcitest:args -Eperfsprint
+//golangcitest:expected_exitcode 0
+package testdata
+
+import (
+	"fmt"
+)
+
+func TestPerfsprint() {
+	var (
+		s   string
+		err error
+	)
+
+	_ = fmt.Sprintf("%s", s)
+	_ = fmt.Sprint(s)
+	_ = fmt.Sprintf("%s", err)
+	_ = fmt.Sprint(err)
+	_ = fmt.Sprintf("Hello %s", s)
+	_ = fmt.Sprintf("test")
+
+	_ = fmt.Sprint("test", 42)
+	_ = fmt.Sprintf("value %d", 42)
+	_ = fmt.Sprintf("%s %v", "hello", "world")
+}
@@ -17,6 +17,8 @@ type EnvKey string
 const (
 	EnvGoCache EnvKey = "GOCACHE"
 	EnvGoRoot  EnvKey = "GOROOT"
+	EnvGoMod   EnvKey = "GOMOD"
+	EnvGoWork  EnvKey = "GOWORK"
 )
 
 type Env struct {
@@ -37,7 +39,8 @@ func (e Env) Discover(ctx context.Context) error {
 	startedAt := time.Now()
 
 	//nolint:gosec // Everything is static here.
-	cmd := exec.CommandContext(ctx, "go", "env", "-json", string(EnvGoCache), string(EnvGoRoot))
+	cmd := exec.CommandContext(ctx, "go", "env", "-json",
+		string(EnvGoCache), string(EnvGoRoot), string(EnvGoMod), string(EnvGoWork))
 
 	out, err := cmd.Output()
 	if err != nil {
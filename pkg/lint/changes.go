@@ -0,0 +1,181 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// fileStamp identifies a version of a file without reading it.
+type fileStamp struct {
+	exists  bool
+	modTime time.Time
+	size    int64
+}
+
+func getFileStamp(path string) fileStamp {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileStamp{}
+	}
+
+	return fileStamp{exists: true, modTime: info.ModTime(), size: info.Size()}
+}
+
+// ChangeTracker detects the changes of the files of the packages between two analyses.
+// The directories of the packages are also tracked to detect the added and the removed files.
+type ChangeTracker struct {
+	stamps map[string]fileStamp
+
+	// the packages containing a file or a directory.
+	pkgsByPath map[string][]*packages.Package
+
+	// the packages importing a package, by package ID.
+	importers map[string][]*packages.Package
+}
+
+func NewChangeTracker() *ChangeTracker {
+	return &ChangeTracker{
+		stamps:     map[string]fileStamp{},
+		pkgsByPath: map[string][]*packages.Package{},
+		importers:  map[string][]*packages.Package{},
+	}
+}
+
+// Track records the state of the files of the packages, and of the extra files (ex: go.mod).
+// It replaces the previously tracked state.
+func (t *ChangeTracker) Track(pkgs []*packages.Package, extraFiles ...string) {
+	t.stamps = map[string]fileStamp{}
+	t.pkgsByPath = map[string][]*packages.Package{}
+	t.importers = map[string][]*packages.Package{}
+
+	for _, file := range extraFiles {
+		t.stamps[file] = getFileStamp(file)
+	}
+
+	initial := map[string]bool{}
+	for _, pkg := range pkgs {
+		initial[pkg.ID] = true
+	}
+
+	seen := map[string]bool{}
+
+	var visit func(pkg *packages.Package)
+	visit = func(pkg *packages.Package) {
+		if seen[pkg.ID] {
+			return
+		}
+		seen[pkg.ID] = true
+
+		for _, imp := range pkg.Imports {
+			t.importers[imp.ID] = append(t.importers[imp.ID], pkg)
+			visit(imp)
+		}
+
+		// The files of the dependencies outside the analyzed packages are not tracked:
+		// they are not expected to change during the life of the process.
+		if len(pkg.GoFiles) == 0 || !initial[pkg.ID] {
+			return
+		}
+
+		for _, file := range packageFiles(pkg) {
+			t.stamps[file] = getFileStamp(file)
+			t.pkgsByPath[file] = append(t.pkgsByPath[file], pkg)
+		}
+
+		dir := filepath.Dir(pkg.GoFiles[0])
+		t.stamps[dir] = getFileStamp(dir)
+		t.pkgsByPath[dir] = append(t.pkgsByPath[dir], pkg)
+	}
+
+	for _, pkg := range pkgs {
+		visit(pkg)
+	}
+}
+
+// Changes returns the tracked files and directories that changed since the last call to Track.
+func (t *ChangeTracker) Changes() []string {
+	var changes []string
+	for path, stamp := range t.stamps {
+		if getFileStamp(path) != stamp {
+			changes = append(changes, path)
+		}
+	}
+
+	sort.Strings(changes)
+
+	return changes
+}
+
+// AffectedPackages returns the tracked packages containing the changed paths, and the packages importing them.
+// A new Go file affects the packages of its directory.
+// A change of an extra file (ex: go.mod) affects all the packages.
+func (t *ChangeTracker) AffectedPackages(changes []string) []*packages.Package {
+	affected := map[string]*packages.Package{}
+
+	var visit func(pkg *packages.Package)
+	visit = func(pkg *packages.Package) {
+		if _, ok := affected[pkg.ID]; ok {
+			return
+		}
+
+		affected[pkg.ID] = pkg
+
+		for _, importer := range t.importers[pkg.ID] {
+			visit(importer)
+		}
+	}
+
+	for _, path := range changes {
+		pkgs, ok := t.pkgsByPath[path]
+		if !ok && filepath.Ext(path) == ".go" {
+			pkgs, ok = t.pkgsByPath[filepath.Dir(path)]
+		}
+
+		if !ok {
+			return t.allPackages()
+		}
+
+		for _, pkg := range pkgs {
+			visit(pkg)
+		}
+	}
+
+	return sortPackages(affected)
+}
+
+func (t *ChangeTracker) allPackages() []*packages.Package {
+	all := map[string]*packages.Package{}
+	for _, pkgs := range t.pkgsByPath {
+		for _, pkg := range pkgs {
+			all[pkg.ID] = pkg
+		}
+	}
+
+	return sortPackages(all)
+}
+
+func packageFiles(pkg *packages.Package) []string {
+	var files []string
+	files = append(files, pkg.GoFiles...)
+	files = append(files, pkg.OtherFiles...)
+	files = append(files, pkg.IgnoredFiles...)
+
+	return files
+}
+
+func sortPackages(pkgs map[string]*packages.Package) []*packages.Package {
+	ret := make([]*packages.Package, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		ret = append(ret, pkg)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].ID < ret[j].ID
+	})
+
+	return ret
+}
@@ -0,0 +1,78 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+)
+
+func TestChangeTracker(t *testing.T) {
+	dir := t.TempDir()
+
+	libFile := writeFile(t, filepath.Join(dir, "lib", "lib.go"), "package lib\n")
+	appFile := writeFile(t, filepath.Join(dir, "app", "app.go"), "package app\n")
+	otherFile := writeFile(t, filepath.Join(dir, "other", "other.go"), "package other\n")
+	goMod := writeFile(t, filepath.Join(dir, "go.mod"), "module example.com\n")
+
+	lib := &packages.Package{ID: "example.com/lib", GoFiles: []string{libFile}}
+	app := &packages.Package{
+		ID:      "example.com/app",
+		GoFiles: []string{appFile},
+		Imports: map[string]*packages.Package{"example.com/lib": lib},
+	}
+	other := &packages.Package{ID: "example.com/other", GoFiles: []string{otherFile}}
+
+	tracker := NewChangeTracker()
+	tracker.Track([]*packages.Package{lib, app, other}, goMod)
+
+	assert.Empty(t, tracker.Changes())
+
+	touch(t, libFile, "package lib\n\nvar A = 1\n")
+
+	changes := tracker.Changes()
+	assert.Equal(t, []string{libFile}, changes)
+	assert.Equal(t, []*packages.Package{app, lib}, tracker.AffectedPackages(changes))
+
+	// A new file changes the directory.
+	newFile := writeFile(t, filepath.Join(dir, "other", "new.go"), "package other\n")
+
+	changes = tracker.Changes()
+	assert.Equal(t, []string{libFile, filepath.Join(dir, "other")}, changes)
+	assert.Equal(t, []*packages.Package{app, lib, other}, tracker.AffectedPackages(changes))
+
+	assert.Equal(t, []*packages.Package{other}, tracker.AffectedPackages([]string{newFile}))
+
+	tracker.Track([]*packages.Package{lib, app, other}, goMod)
+
+	assert.Empty(t, tracker.Changes())
+
+	touch(t, goMod, "module example.com\n\ngo 1.22\n")
+
+	changes = tracker.Changes()
+	assert.Equal(t, []string{goMod}, changes)
+	assert.Equal(t, []*packages.Package{app, lib, other}, tracker.AffectedPackages(changes))
+}
+
+func writeFile(t *testing.T, path, content string) string {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+// touch updates a file, and ensures that its modification time changes.
+func touch(t *testing.T, path, content string) {
+	t.Helper()
+
+	writeFile(t, path, content)
+
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(path, future, future))
+}
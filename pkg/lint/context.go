@@ -58,6 +58,7 @@ func (cl *ContextBuilder) Build(ctx context.Context, log logutils.Log, linters [
 		FileCache: cl.fileCache,
 		PkgCache:  cl.pkgCache,
 		LoadGuard: cl.loadGuard,
+		Overlay:   cl.pkgLoader.overlay,
 	}
 
 	return ret, nil
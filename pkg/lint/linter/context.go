@@ -26,6 +26,10 @@ type Context struct {
 
 	PkgCache  *pkgcache.Cache
 	LoadGuard *load.Guard
+
+	// Overlay contains the contents of the files that differ from the disk (ex: unsaved editor buffers),
+	// by absolute path.
+	Overlay map[string][]byte
 }
 
 func (c *Context) Settings() *config.LintersSettings {
@@ -14,6 +14,30 @@ import (
 	"github.com/snowmerak/golangci-lint/pkg/logutils"
 )
 
+// Kinds of reasons for which a linter is enabled.
+const (
+	EnabledByDefault   = "default"
+	EnabledByEnableAll = "enable-all"
+	EnabledByPreset    = "preset"
+	EnabledExplicitly  = "explicit"
+)
+
+// EnabledReason is the reason for which a linter is enabled.
+type EnabledReason struct {
+	// The kind of reason: default, enable-all, preset, or explicit.
+	Kind string
+	// The name of the preset, for the kind preset.
+	Preset string
+}
+
+func (r EnabledReason) String() string {
+	if r.Kind == EnabledByPreset {
+		return fmt.Sprintf("%s %s", r.Kind, r.Preset)
+	}
+
+	return r.Kind
+}
+
 type Builder interface {
 	Build(cfg *config.Config) ([]*linter.Config, error)
 }
@@ -132,6 +156,13 @@ func (m *Manager) GetOptimizedLinters() ([]*linter.Config, error) {
 	return resultLinters, nil
 }
 
+// GetEnabledLintersReasons returns the reasons for which the enabled linters are enabled, by linter name.
+func (m *Manager) GetEnabledLintersReasons() map[string]EnabledReason {
+	_, reasons := m.buildWithReasons(m.GetAllEnabledByDefaultLinters())
+
+	return reasons
+}
+
 func (m *Manager) GetAllEnabledByDefaultLinters() []*linter.Config {
 	var ret []*linter.Config
 	for _, lc := range m.linters {
@@ -143,10 +174,18 @@ func (m *Manager) GetAllEnabledByDefaultLinters() []*linter.Config {
 	return ret
 }
 
-//nolint:gocyclo // the complexity cannot be reduced.
 func (m *Manager) build(enabledByDefaultLinters []*linter.Config) map[string]*linter.Config {
+	resultLintersSet, _ := m.buildWithReasons(enabledByDefaultLinters)
+
+	return resultLintersSet
+}
+
+//nolint:gocyclo // the complexity cannot be reduced.
+func (m *Manager) buildWithReasons(enabledByDefaultLinters []*linter.Config) (map[string]*linter.Config, map[string]EnabledReason) {
 	m.debugf("Linters config: %#v", m.cfg.Linters)
 
+	reasons := map[string]EnabledReason{}
+
 	resultLintersSet := map[string]*linter.Config{}
 	switch {
 	case m.cfg.Linters.DisableAll:
@@ -155,8 +194,10 @@ func (m *Manager) build(enabledByDefaultLinters []*linter.Config) map[string]*li
 		// imply --disable-all
 	case m.cfg.Linters.EnableAll:
 		resultLintersSet = linterConfigsToMap(m.linters)
+		setReasons(reasons, resultLintersSet, EnabledReason{Kind: EnabledByEnableAll})
 	default:
 		resultLintersSet = linterConfigsToMap(enabledByDefaultLinters)
+		setReasons(reasons, resultLintersSet, EnabledReason{Kind: EnabledByDefault})
 	}
 
 	// --presets can only add linters to default set
@@ -164,6 +205,7 @@ func (m *Manager) build(enabledByDefaultLinters []*linter.Config) map[string]*li
 		for _, lc := range m.GetAllLinterConfigsForPreset(p) {
 			lc := lc
 			resultLintersSet[lc.Name()] = lc
+			reasons[lc.Name()] = EnabledReason{Kind: EnabledByPreset, Preset: p}
 		}
 	}
 
@@ -174,6 +216,7 @@ func (m *Manager) build(enabledByDefaultLinters []*linter.Config) map[string]*li
 		for name, lc := range resultLintersSet {
 			if lc.IsSlowLinter() {
 				delete(resultLintersSet, name)
+				delete(reasons, name)
 			}
 		}
 	}
@@ -182,6 +225,7 @@ func (m *Manager) build(enabledByDefaultLinters []*linter.Config) map[string]*li
 		for _, lc := range m.GetLinterConfigs(name) {
 			// it's important to use lc.Name() nor name because name can be alias
 			resultLintersSet[lc.Name()] = lc
+			reasons[lc.Name()] = EnabledReason{Kind: EnabledExplicitly}
 		}
 	}
 
@@ -189,6 +233,7 @@ func (m *Manager) build(enabledByDefaultLinters []*linter.Config) map[string]*li
 		for _, lc := range m.GetLinterConfigs(name) {
 			// it's important to use lc.Name() nor name because name can be alias
 			delete(resultLintersSet, lc.Name())
+			delete(reasons, lc.Name())
 		}
 	}
 
@@ -197,10 +242,17 @@ func (m *Manager) build(enabledByDefaultLinters []*linter.Config) map[string]*li
 		for _, lc := range m.GetLinterConfigs("typecheck") {
 			// it's important to use lc.Name() nor name because name can be alias
 			resultLintersSet[lc.Name()] = lc
+			reasons[lc.Name()] = EnabledReason{Kind: EnabledByDefault}
 		}
 	}
 
-	return resultLintersSet
+	return resultLintersSet, reasons
+}
+
+func setReasons(reasons map[string]EnabledReason, linters map[string]*linter.Config, reason EnabledReason) {
+	for name := range linters {
+		reasons[name] = reason
+	}
 }
 
 func (m *Manager) combineGoAnalysisLinters(linters map[string]*linter.Config) {
@@ -35,6 +35,37 @@ func TestManager_GetEnabledLintersMap(t *testing.T) {
 	assert.Equal(t, expected, lintersMap)
 }
 
+func TestManager_GetEnabledLintersReasons(t *testing.T) {
+	cfg := config.NewDefault()
+	cfg.Linters.Presets = []string{"unused"}
+	cfg.Linters.Enable = []string{"gofmt", "unused"}
+	cfg.Linters.Disable = []string{"unparam"}
+
+	m, err := NewManager(logutils.NewStderrLog("skip"), cfg, NewLinterBuilder())
+	require.NoError(t, err)
+
+	reasons := m.GetEnabledLintersReasons()
+
+	assert.Equal(t, EnabledReason{Kind: EnabledExplicitly}, reasons["gofmt"])
+	assert.Equal(t, EnabledReason{Kind: EnabledExplicitly}, reasons["unused"])
+	assert.Equal(t, EnabledReason{Kind: EnabledByPreset, Preset: "unused"}, reasons["ineffassign"])
+	assert.NotContains(t, reasons, "unparam")
+	assert.NotContains(t, reasons, "errcheck")
+
+	cfg = config.NewDefault()
+	cfg.Linters.EnableAll = true
+
+	m, err = NewManager(logutils.NewStderrLog("skip"), cfg, NewLinterBuilder())
+	require.NoError(t, err)
+
+	assert.Equal(t, EnabledReason{Kind: EnabledByEnableAll}, m.GetEnabledLintersReasons()["gofmt"])
+
+	m, err = NewManager(logutils.NewStderrLog("skip"), config.NewDefault(), NewLinterBuilder())
+	require.NoError(t, err)
+
+	assert.Equal(t, EnabledReason{Kind: EnabledByDefault}, m.GetEnabledLintersReasons()["errcheck"])
+}
+
 func TestManager_GetOptimizedLinters(t *testing.T) {
 	cfg := config.NewDefault()
 	cfg.Linters.DisableAll = true
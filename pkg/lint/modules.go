@@ -0,0 +1,158 @@
+package lint
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+)
+
+// Module is a Go module analyzed by a multi-module run.
+type Module struct {
+	// The module path.
+	Path string
+	// The absolute path of the root directory of the module.
+	Dir string
+}
+
+// FindModules returns the modules to analyze under the root directories.
+// If goWork is the path of a workspace file, the modules are the ones used by the workspace;
+// otherwise, the modules are found by walking the directory trees.
+// The directories matched by skipDir are not walked, the hidden, vendor, and testdata directories are never walked.
+func FindModules(goWork string, roots []string, skipDir func(dir string) bool) ([]Module, error) {
+	var (
+		modules []Module
+		err     error
+	)
+
+	if goWork != "" && goWork != "off" {
+		modules, err = findWorkspaceModules(goWork, roots)
+	} else {
+		modules, err = findTreeModules(roots, skipDir)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(modules, func(i, j int) bool {
+		return modules[i].Dir < modules[j].Dir
+	})
+
+	return modules, nil
+}
+
+func findWorkspaceModules(goWork string, roots []string) ([]Module, error) {
+	data, err := os.ReadFile(goWork)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read workspace file: %w", err)
+	}
+
+	work, err := modfile.ParseWork(goWork, data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse workspace file: %w", err)
+	}
+
+	workDir := filepath.Dir(goWork)
+
+	var modules []Module
+	for _, use := range work.Use {
+		dir := filepath.FromSlash(use.Path)
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(workDir, dir)
+		}
+
+		if !isUnderAny(dir, roots) {
+			continue
+		}
+
+		module, err := readModule(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		modules = append(modules, module)
+	}
+
+	return modules, nil
+}
+
+func findTreeModules(roots []string, skipDir func(dir string) bool) ([]Module, error) {
+	seen := map[string]bool{}
+
+	var modules []Module
+	for _, root := range roots {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !d.IsDir() {
+				return nil
+			}
+
+			if path != root && (isIgnoredDirName(d.Name()) || skipDir(path)) {
+				return filepath.SkipDir
+			}
+
+			if seen[path] {
+				return filepath.SkipDir
+			}
+
+			seen[path] = true
+
+			if _, err := os.Stat(filepath.Join(path, "go.mod")); err != nil {
+				return nil
+			}
+
+			module, err := readModule(path)
+			if err != nil {
+				return err
+			}
+
+			modules = append(modules, module)
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return modules, nil
+}
+
+func readModule(dir string) (Module, error) {
+	goMod := filepath.Join(dir, "go.mod")
+
+	data, err := os.ReadFile(goMod)
+	if err != nil {
+		return Module{}, fmt.Errorf("failed to read module file: %w", err)
+	}
+
+	path := modfile.ModulePath(data)
+	if path == "" {
+		return Module{}, fmt.Errorf("no module path in %s", goMod)
+	}
+
+	return Module{Path: path, Dir: dir}, nil
+}
+
+func isIgnoredDirName(name string) bool {
+	return strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") || name == "vendor" || name == "testdata"
+}
+
+func isUnderAny(dir string, roots []string) bool {
+	for _, root := range roots {
+		rel, err := filepath.Rel(root, dir)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,113 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindModules_tree(t *testing.T) {
+	root := t.TempDir()
+
+	writeModule(t, root, "example.com/root")
+	writeModule(t, filepath.Join(root, "a"), "example.com/a")
+	writeModule(t, filepath.Join(root, "b", "c"), "example.com/c")
+	writeModule(t, filepath.Join(root, "vendor", "v"), "example.com/v")
+	writeModule(t, filepath.Join(root, ".hidden"), "example.com/hidden")
+	writeModule(t, filepath.Join(root, "skipped"), "example.com/skipped")
+
+	skipDir := func(dir string) bool { return filepath.Base(dir) == "skipped" }
+
+	modules, err := FindModules("", []string{root}, skipDir)
+	require.NoError(t, err)
+
+	expected := []Module{
+		{Path: "example.com/root", Dir: root},
+		{Path: "example.com/a", Dir: filepath.Join(root, "a")},
+		{Path: "example.com/c", Dir: filepath.Join(root, "b", "c")},
+	}
+
+	assert.Equal(t, expected, modules)
+}
+
+func TestFindModules_workspace(t *testing.T) {
+	root := t.TempDir()
+
+	writeModule(t, filepath.Join(root, "a"), "example.com/a")
+	writeModule(t, filepath.Join(root, "b"), "example.com/b")
+	writeModule(t, filepath.Join(root, "unused"), "example.com/unused")
+
+	goWork := filepath.Join(root, "go.work")
+	require.NoError(t, os.WriteFile(goWork, []byte("go 1.22\n\nuse (\n\t./a\n\t./b\n)\n"), 0o600))
+
+	modules, err := FindModules(goWork, []string{root}, func(string) bool { return false })
+	require.NoError(t, err)
+
+	expected := []Module{
+		{Path: "example.com/a", Dir: filepath.Join(root, "a")},
+		{Path: "example.com/b", Dir: filepath.Join(root, "b")},
+	}
+
+	assert.Equal(t, expected, modules)
+
+	// Only the modules under the roots are analyzed.
+	modules, err = FindModules(goWork, []string{filepath.Join(root, "b")}, func(string) bool { return false })
+	require.NoError(t, err)
+
+	assert.Equal(t, expected[1:], modules)
+}
+
+func TestFindModules_workspaceSyntax(t *testing.T) {
+	root := t.TempDir()
+
+	writeModule(t, filepath.Join(root, "a"), "example.com/a")
+	writeModule(t, filepath.Join(root, "b c"), "example.com/bc")
+	writeModule(t, filepath.Join(root, "d"), "example.com/d")
+	writeModule(t, filepath.Join(root, "e"), "example.com/e")
+
+	data := `go 1.22
+
+// use ./commented
+use ./a // comment
+use "./b c"
+
+use(
+	./d
+	// ./e
+)
+
+replace example.com/f => ./f
+`
+
+	goWork := filepath.Join(root, "go.work")
+	require.NoError(t, os.WriteFile(goWork, []byte(data), 0o600))
+
+	modules, err := FindModules(goWork, []string{root}, func(string) bool { return false })
+	require.NoError(t, err)
+
+	expected := []Module{
+		{Path: "example.com/a", Dir: filepath.Join(root, "a")},
+		{Path: "example.com/bc", Dir: filepath.Join(root, "b c")},
+		{Path: "example.com/d", Dir: filepath.Join(root, "d")},
+	}
+
+	assert.Equal(t, expected, modules)
+}
+
+func TestFindModules_invalidWorkspace(t *testing.T) {
+	goWork := filepath.Join(t.TempDir(), "go.work")
+	require.NoError(t, os.WriteFile(goWork, []byte("go 1.22\n\nuse (\n"), 0o600))
+
+	_, err := FindModules(goWork, []string{filepath.Dir(goWork)}, func(string) bool { return false })
+	require.Error(t, err)
+}
+
+func writeModule(t *testing.T, dir, path string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module "+path+"\n\ngo 1.22\n"), 0o600))
+}
@@ -0,0 +1,121 @@
+package lint
+
+import (
+	"slices"
+
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/fsutils"
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+	"github.com/snowmerak/golangci-lint/pkg/result/processors"
+)
+
+// OutputProcessing processes the issues of one or several analyses for the output:
+// the limits of the configuration apply to all the issues, the fixes are applied, and the paths are prepared.
+type OutputProcessing struct {
+	log logutils.Log
+
+	processors []processors.Processor
+
+	pathPrefixer *processors.PathPrefixer
+	sortResults  *processors.SortResults
+}
+
+func NewOutputProcessing(log logutils.Log, cfg *config.Config,
+	lineCache *fsutils.LineCache, fileCache *fsutils.FileCache,
+) (*OutputProcessing, error) {
+	return newOutputProcessing(log, cfg, lineCache, fileCache, needFingerprints(cfg))
+}
+
+// NewFingerprintingOutputProcessing is like NewOutputProcessing, but the fingerprints of the issues are always computed:
+// they identify the same issues in several analyses (ex: the watch mode).
+func NewFingerprintingOutputProcessing(log logutils.Log, cfg *config.Config,
+	lineCache *fsutils.LineCache, fileCache *fsutils.FileCache,
+) (*OutputProcessing, error) {
+	return newOutputProcessing(log, cfg, lineCache, fileCache, true)
+}
+
+func newOutputProcessing(log logutils.Log, cfg *config.Config,
+	lineCache *fsutils.LineCache, fileCache *fsutils.FileCache, fingerprints bool,
+) (*OutputProcessing, error) {
+	baselineProcessor, err := processors.NewBaseline(log.Child(logutils.DebugKeyBaseline), &cfg.Issues)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceCodeProcessor := processors.NewSourceCode(lineCache, log.Child(logutils.DebugKeySourceCode))
+
+	// The fingerprints need the source code of all the issues: it's only read before the limits when they are used.
+	var fingerprintProcessors, limitedSourceCodeProcessors []processors.Processor
+	if fingerprints {
+		fingerprintProcessors = []processors.Processor{
+			// The source code is used by the fingerprints.
+			sourceCodeProcessor,
+			processors.NewFingerprint(),
+
+			// Must be before the limits: the baseline must contain all the issues.
+			processors.NewSkipSuppressed(baselineProcessor),
+		}
+	} else {
+		limitedSourceCodeProcessors = []processors.Processor{sourceCodeProcessor}
+	}
+
+	pathPrefixer := processors.NewPathPrefixer(cfg.Output.PathPrefix)
+
+	return &OutputProcessing{
+		log: log,
+		processors: slices.Concat([]processors.Processor{
+			processors.NewSkipSuppressed(processors.NewUniqByLine(cfg)),
+		}, fingerprintProcessors, []processors.Processor{
+			processors.NewSkipSuppressed(processors.NewMaxPerFileFromLinter(cfg)),
+			processors.NewSkipSuppressed(processors.NewMaxSameIssues(cfg.Issues.MaxSameIssues, log.Child(logutils.DebugKeyMaxSameIssues), cfg)),
+			processors.NewSkipSuppressed(processors.NewMaxFromLinter(cfg.Issues.MaxIssuesPerLinter, log.Child(logutils.DebugKeyMaxFromLinter), cfg)),
+		}, limitedSourceCodeProcessors, []processors.Processor{
+			processors.NewPathShortener(),
+
+			// The fixer still needs to see paths for the issues that are relative to the current directory.
+			processors.NewSkipSuppressed(processors.NewFixer(cfg, log, fileCache)),
+
+			// Now we can modify the issues for output.
+			pathPrefixer,
+		}),
+		pathPrefixer: pathPrefixer,
+		sortResults:  processors.NewSortResults(cfg),
+	}, nil
+}
+
+// Process processes the issues for the output.
+// The issues about the configuration (ex: the expired suppressions) are not limited or fixed:
+// they only get the path prefix, then all the issues are sorted.
+func (p *OutputProcessing) Process(issues, reported []result.Issue) []result.Issue {
+	issues = processLintResults(p.log, p.processors, issues)
+
+	// The path prefixer never fails.
+	reported, _ = p.pathPrefixer.Process(reported)
+
+	issues = append(issues, reported...)
+
+	sorted, err := p.sortResults.Process(issues)
+	if err != nil {
+		p.log.Warnf("Can't process result by %s processor: %s", p.sortResults.Name(), err)
+		return issues
+	}
+
+	return sorted
+}
+
+// needFingerprints reports whether the fingerprints of the issues are used: by the baseline or by an output format.
+func needFingerprints(cfg *config.Config) bool {
+	if cfg.Issues.Baseline != "" || cfg.Issues.BaselineWrite != "" {
+		return true
+	}
+
+	return slices.ContainsFunc(cfg.Output.Formats, func(format config.OutputFormat) bool {
+		// The templates can use the fingerprints of the issues.
+		if _, ok := format.TemplatePath(); ok {
+			return true
+		}
+
+		return format.Format == config.OutFormatSarif || format.Format == config.OutFormatCodeClimate
+	})
+}
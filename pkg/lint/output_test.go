@@ -0,0 +1,122 @@
+package lint
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/fsutils"
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+func TestOutputProcessing_Process_reported(t *testing.T) {
+	cfg := config.NewDefault()
+	cfg.Issues.MaxIssuesPerLinter = 1
+	cfg.Output.PathPrefix = "prefix"
+	cfg.Output.SortResults = true
+
+	fileCache := fsutils.NewFileCache()
+
+	p, err := NewOutputProcessing(logutils.NewStderrLog(logutils.DebugKeyEmpty), cfg, fsutils.NewLineCache(fileCache), fileCache)
+	require.NoError(t, err)
+
+	newIssue := func(filename string, line int) result.Issue {
+		return result.Issue{FromLinter: "unusedexcludes", Text: "unused", Pos: token.Position{Filename: filename, Line: line}}
+	}
+
+	// The issues about the configuration are not limited, and are sorted with the other issues.
+	issues := p.Process(nil, []result.Issue{newIssue(".golangci.yml", 9), newIssue(".golangci.yml", 3)})
+
+	expected := []result.Issue{
+		newIssue(filepath.Join("prefix", ".golangci.yml"), 3),
+		newIssue(filepath.Join("prefix", ".golangci.yml"), 9),
+	}
+
+	assert.Equal(t, expected, issues)
+}
+
+func TestNewFingerprintingOutputProcessing(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "main.go")
+	require.NoError(t, os.WriteFile(filePath, []byte("package main\n\nfunc main() {}\n"), 0o600))
+
+	cfg := config.NewDefault()
+
+	fileCache := fsutils.NewFileCache()
+
+	p, err := NewFingerprintingOutputProcessing(logutils.NewStderrLog(logutils.DebugKeyEmpty), cfg, fsutils.NewLineCache(fileCache), fileCache)
+	require.NoError(t, err)
+
+	// The configuration doesn't use the fingerprints.
+	require.False(t, needFingerprints(cfg))
+
+	issues := p.Process([]result.Issue{{FromLinter: "linter", Text: "issue", Pos: token.Position{Filename: filePath, Line: 3}}}, nil)
+
+	require.Len(t, issues, 1)
+	assert.Equal(t, "main", issues[0].Symbol)
+	assert.Equal(t, []string{"func main() {}"}, issues[0].SourceLines)
+}
+
+func Test_needFingerprints(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		cfg      *config.Config
+		expected bool
+	}{
+		{
+			desc: "default",
+			cfg: &config.Config{
+				Output: config.Output{Formats: config.OutputFormats{{Format: config.OutFormatColoredLineNumber}}},
+			},
+		},
+		{
+			desc: "baseline",
+			cfg: &config.Config{
+				Issues: config.Issues{Baseline: "baseline.json"},
+			},
+			expected: true,
+		},
+		{
+			desc: "baseline write",
+			cfg: &config.Config{
+				Issues: config.Issues{BaselineWrite: "baseline.json"},
+			},
+			expected: true,
+		},
+		{
+			desc: "sarif",
+			cfg: &config.Config{
+				Output: config.Output{Formats: config.OutputFormats{{Format: config.OutFormatJSON}, {Format: config.OutFormatSarif}}},
+			},
+			expected: true,
+		},
+		{
+			desc: "code climate",
+			cfg: &config.Config{
+				Output: config.Output{Formats: config.OutputFormats{{Format: config.OutFormatCodeClimate}}},
+			},
+			expected: true,
+		},
+		{
+			desc: "template",
+			cfg: &config.Config{
+				Output: config.Output{Formats: config.OutputFormats{{Format: "template:report.tmpl"}}},
+			},
+			expected: true,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, needFingerprints(test.cfg))
+		})
+	}
+}
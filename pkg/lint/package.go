@@ -35,6 +35,10 @@ type PackageLoader struct {
 	goenv *goutil.Env
 
 	loadGuard *load.Guard
+
+	overlay map[string][]byte
+
+	dir string
 }
 
 // NewPackageLoader creates a new PackageLoader.
@@ -50,6 +54,17 @@ func NewPackageLoader(log logutils.Log, cfg *config.Config, args []string, goenv
 	}
 }
 
+// SetOverlay sets the contents of the files that differ from the disk, by absolute path.
+func (l *PackageLoader) SetOverlay(overlay map[string][]byte) {
+	l.overlay = overlay
+}
+
+// SetDir sets the directory where the packages are loaded (ex: the root directory of a module).
+// The packages are loaded from the working directory by default.
+func (l *PackageLoader) SetDir(dir string) {
+	l.dir = dir
+}
+
 // Load loads packages.
 func (l *PackageLoader) Load(ctx context.Context, linters []*linter.Config) (pkgs, deduplicatedPkgs []*packages.Package, err error) {
 	loadMode := findLoadMode(linters)
@@ -75,7 +90,9 @@ func (l *PackageLoader) loadPackages(ctx context.Context, loadMode packages.Load
 		Context:    ctx,
 		BuildFlags: l.makeBuildFlags(),
 		Logf:       l.debugf,
-		// TODO: use fset, parsefile, overlay
+		Overlay:    l.overlay,
+		Dir:        l.dir,
+		// TODO: use fset, parsefile
 	}
 
 	args := buildArgs(l.args)
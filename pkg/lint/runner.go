@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"runtime/debug"
+	"slices"
 	"strings"
 
 	"github.com/snowmerak/golangci-lint/internal/errorutil"
@@ -29,6 +30,15 @@ type Runner struct {
 
 	lintCtx    *linter.Context
 	Processors []processors.Processor
+
+	output *OutputProcessing
+
+	nolint         *processors.Nolint
+	suppressions   *processors.Suppressions
+	severity       *processors.Severity
+	unusedExcludes *processors.UnusedExcludes
+
+	suppressed []result.Issue
 }
 
 func NewRunner(log logutils.Log, cfg *config.Config, args []string, goenv *goutil.Env,
@@ -55,11 +65,31 @@ func NewRunner(log logutils.Log, cfg *config.Config, args []string, goenv *gouti
 		return nil, err
 	}
 
+	output, err := NewOutputProcessing(log, cfg, lineCache, fileCache)
+	if err != nil {
+		return nil, err
+	}
+
 	enabledLinters, err := dbManager.GetEnabledLintersMap()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get enabled linters: %w", err)
 	}
 
+	nolintProcessor := processors.NewNolint(log.Child(logutils.DebugKeyNolint), dbManager, enabledLinters,
+		cfg.Output.PrintSuppressed)
+
+	suppressionsProcessor, err := processors.NewSuppressions(log.Child(logutils.DebugKeySuppressions), dbManager, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	excludeProcessor := processors.NewExclude(&cfg.Issues)
+	excludeRulesProcessor := processors.NewExcludeRules(log.Child(logutils.DebugKeyExcludeRules), files, &cfg.Issues)
+	severityProcessor := processors.NewSeverity(log.Child(logutils.DebugKeySeverityRules), files, &cfg.Severity)
+
+	unusedExcludesProcessor := processors.NewUnusedExcludes(cfg,
+		skipFilesProcessor, skipDirsProcessor, excludeProcessor, excludeRulesProcessor, severityProcessor)
+
 	return &Runner{
 		Processors: []processors.Processor{
 			processors.NewCgo(goenv),
@@ -80,39 +110,51 @@ func NewRunner(log logutils.Log, cfg *config.Config, args []string, goenv *gouti
 			// Must be before exclude because users see already marked output and configure excluding by it.
 			processors.NewIdentifierMarker(),
 
-			processors.NewExclude(&cfg.Issues),
-			processors.NewExcludeRules(log.Child(logutils.DebugKeyExcludeRules), files, &cfg.Issues),
-			processors.NewNolint(log.Child(logutils.DebugKeyNolint), dbManager, enabledLinters),
+			excludeProcessor,
+			excludeRulesProcessor,
+			nolintProcessor,
+
+			processors.NewSkipSuppressed(suppressionsProcessor),
 
-			processors.NewUniqByLine(cfg),
 			processors.NewDiff(&cfg.Issues),
-			processors.NewMaxPerFileFromLinter(cfg),
-			processors.NewMaxSameIssues(cfg.Issues.MaxSameIssues, log.Child(logutils.DebugKeyMaxSameIssues), cfg),
-			processors.NewMaxFromLinter(cfg.Issues.MaxIssuesPerLinter, log.Child(logutils.DebugKeyMaxFromLinter), cfg),
-			processors.NewSourceCode(lineCache, log.Child(logutils.DebugKeySourceCode)),
-			processors.NewPathShortener(),
-			processors.NewSeverity(log.Child(logutils.DebugKeySeverityRules), files, &cfg.Severity),
-
-			// The fixer still needs to see paths for the issues that are relative to the current directory.
-			processors.NewFixer(cfg, log, fileCache),
-
-			// Now we can modify the issues for output.
-			processors.NewPathPrefixer(cfg.Output.PathPrefix),
-			processors.NewSortResults(cfg),
+
+			// The severity rules depend on the configuration of the analysis: they are applied before the output processing.
+			severityProcessor,
+
+			// Must be the last processor: the exclusions are collected when the other processors are finished.
+			unusedExcludesProcessor,
 		},
-		lintCtx: lintCtx,
-		Log:     log,
+		output:         output,
+		lintCtx:        lintCtx,
+		nolint:         nolintProcessor,
+		suppressions:   suppressionsProcessor,
+		severity:       severityProcessor,
+		unusedExcludes: unusedExcludesProcessor,
+		Log:            log,
 	}, nil
 }
 
+// Run runs the linters, and processes the issues for the output.
 func (r *Runner) Run(ctx context.Context, linters []*linter.Config) ([]result.Issue, error) {
+	issues, reported, lintErrors := r.Analyze(ctx, linters)
+
+	issues = r.output.Process(issues, reported)
+
+	// The suppressed issues went through the processors to be printed like the other issues (ex: with the same fingerprints),
+	// but they are reported separately.
+	issues, r.suppressed = processors.SplitSuppressed(issues)
+
+	return issues, lintErrors
+}
+
+// Analyze runs the linters and the processors filtering the issues, without the output processing:
+// the issues of several analyses can be merged, then processed for the output at once (see OutputProcessing).
+// The issues about the configuration (ex: the expired suppressions) are returned separately.
+func (r *Runner) Analyze(ctx context.Context, linters []*linter.Config) (issues, reported []result.Issue, err error) {
 	sw := timeutils.NewStopwatch("linters", r.Log)
 	defer sw.Print()
 
-	var (
-		lintErrors error
-		issues     []result.Issue
-	)
+	var lintErrors error
 
 	for _, lc := range linters {
 		lc := lc
@@ -129,7 +171,36 @@ func (r *Runner) Run(ctx context.Context, linters []*linter.Config) ([]result.Is
 		})
 	}
 
-	return r.processLintResults(issues), lintErrors
+	issues = processLintResults(r.Log, r.Processors, issues)
+
+	// The expired suppressions and the exclusions which matched no issue are reported after the processing:
+	// they only get the severity, the output processing adds the path prefix and sorts them with the other issues.
+	reported = slices.Concat(r.suppressions.Issues(), r.unusedExcludes.Issues())
+
+	if processed, processErr := r.severity.Process(reported); processErr != nil {
+		r.Log.Warnf("Can't process result by %s processor: %s", r.severity.Name(), processErr)
+	} else {
+		reported = processed
+	}
+
+	return issues, reported, lintErrors
+}
+
+// SuppressedIssues returns the issues suppressed by nolint directives and by the suppressions file,
+// only collected when `output.print-suppressed` is enabled.
+func (r *Runner) SuppressedIssues() []result.Issue {
+	return r.suppressed
+}
+
+// NolintDirectives returns the nolint directives of the files (relative to the working directory),
+// with the linters of the issues they suppressed during the run.
+func (r *Runner) NolintDirectives(filePaths []string) []processors.NolintDirective {
+	return r.nolint.Directives(filePaths)
+}
+
+// Exclusions returns the exclusions of the configuration with the numbers of issues they matched during the run.
+func (r *Runner) Exclusions() []processors.Exclusion {
+	return r.unusedExcludes.Exclusions()
 }
 
 func (r *Runner) runLinterSafe(ctx context.Context, lintCtx *linter.Context,
@@ -173,8 +244,8 @@ func (r *Runner) runLinterSafe(ctx context.Context, lintCtx *linter.Context,
 	return issues, nil
 }
 
-func (r *Runner) processLintResults(inIssues []result.Issue) []result.Issue {
-	sw := timeutils.NewStopwatch("processing", r.Log)
+func processLintResults(log logutils.Log, procs []processors.Processor, inIssues []result.Issue) []result.Issue {
+	sw := timeutils.NewStopwatch("processing", log)
 
 	var issuesBefore, issuesAfter int
 	statPerProcessor := map[string]processorStat{}
@@ -182,13 +253,13 @@ func (r *Runner) processLintResults(inIssues []result.Issue) []result.Issue {
 	var outIssues []result.Issue
 	if len(inIssues) != 0 {
 		issuesBefore += len(inIssues)
-		outIssues = r.processIssues(inIssues, sw, statPerProcessor)
+		outIssues = processIssues(log, procs, inIssues, sw, statPerProcessor)
 		issuesAfter += len(outIssues)
 	}
 
 	// finalize processors: logging, clearing, no heavy work here
 
-	for _, p := range r.Processors {
+	for _, p := range procs {
 		p := p
 		sw.TrackStage(p.Name(), func() {
 			p.Finish()
@@ -196,15 +267,15 @@ func (r *Runner) processLintResults(inIssues []result.Issue) []result.Issue {
 	}
 
 	if issuesBefore != issuesAfter {
-		r.Log.Infof("Issues before processing: %d, after processing: %d", issuesBefore, issuesAfter)
+		log.Infof("Issues before processing: %d, after processing: %d", issuesBefore, issuesAfter)
 	}
-	r.printPerProcessorStat(statPerProcessor)
+	printPerProcessorStat(log, statPerProcessor)
 	sw.PrintStages()
 
 	return outIssues
 }
 
-func (r *Runner) printPerProcessorStat(stat map[string]processorStat) {
+func printPerProcessorStat(log logutils.Log, stat map[string]processorStat) {
 	parts := make([]string, 0, len(stat))
 	for name, ps := range stat {
 		if ps.inCount != 0 {
@@ -212,12 +283,14 @@ func (r *Runner) printPerProcessorStat(stat map[string]processorStat) {
 		}
 	}
 	if len(parts) != 0 {
-		r.Log.Infof("Processors filtering stat (out/in): %s", strings.Join(parts, ", "))
+		log.Infof("Processors filtering stat (out/in): %s", strings.Join(parts, ", "))
 	}
 }
 
-func (r *Runner) processIssues(issues []result.Issue, sw *timeutils.Stopwatch, statPerProcessor map[string]processorStat) []result.Issue {
-	for _, p := range r.Processors {
+func processIssues(log logutils.Log, procs []processors.Processor, issues []result.Issue,
+	sw *timeutils.Stopwatch, statPerProcessor map[string]processorStat,
+) []result.Issue {
+	for _, p := range procs {
 		var newIssues []result.Issue
 		var err error
 		p := p
@@ -226,7 +299,7 @@ func (r *Runner) processIssues(issues []result.Issue, sw *timeutils.Stopwatch, s
 		})
 
 		if err != nil {
-			r.Log.Warnf("Can't process result by %s processor: %s", p.Name(), err)
+			log.Warnf("Can't process result by %s processor: %s", p.Name(), err)
 		} else {
 			stat := statPerProcessor[p.Name()]
 			stat.inCount += len(issues)
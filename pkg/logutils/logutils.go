@@ -17,8 +17,10 @@ const envDebug = "GL_DEBUG"
 
 const (
 	DebugKeyAutogenExclude     = "autogen_exclude" // Debugs a filter excluding autogenerated source code.
+	DebugKeyBaseline           = "baseline"
 	DebugKeyBinSalt            = "bin_salt"
 	DebugKeyConfigReader       = "config_reader"
+	DebugKeyDaemon             = "daemon"
 	DebugKeyEmpty              = ""
 	DebugKeyEnabledLinters     = "enabled_linters"
 	DebugKeyEnv                = "env" // Debugs `go env` command.
@@ -33,6 +35,7 @@ const (
 	DebugKeyLintersDB          = "lintersdb"
 	DebugKeyLintersOutput      = "linters_output"
 	DebugKeyLoader             = "loader" // Debugs packages loading (including `go/packages` internal debugging).
+	DebugKeyLSP                = "lsp"
 	DebugKeyMaxFromLinter      = "max_from_linter"
 	DebugKeyMaxSameIssues      = "max_same_issues"
 	DebugKeyPkgCache           = "pkgcache"
@@ -41,9 +44,11 @@ const (
 	DebugKeySkipDirs           = "skip_dirs"
 	DebugKeySourceCode         = "source_code"
 	DebugKeyStopwatch          = "stopwatch"
+	DebugKeySuppressions       = "suppressions"
 	DebugKeyTabPrinter         = "tab_printer"
 	DebugKeyTest               = "test"
 	DebugKeyTextPrinter        = "text_printer"
+	DebugKeyWatch              = "watch"
 )
 
 const (
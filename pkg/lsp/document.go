@@ -0,0 +1,112 @@
+package lsp
+
+import (
+	"net/url"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// document converts the byte offsets of a file to LSP positions.
+type document struct {
+	content    []byte
+	lineStarts []int
+}
+
+func newDocument(content []byte) *document {
+	lineStarts := []int{0}
+	for i, b := range content {
+		if b == '\n' {
+			lineStarts = append(lineStarts, i+1)
+		}
+	}
+
+	return &document{content: content, lineStarts: lineStarts}
+}
+
+// lineCount returns the number of lines of the document.
+func (d *document) lineCount() int {
+	return len(d.lineStarts)
+}
+
+// lineStart returns the offset of the beginning of the line (1-based).
+func (d *document) lineStart(line int) int {
+	line = min(max(line, 1), d.lineCount())
+
+	return d.lineStarts[line-1]
+}
+
+// lineEnd returns the offset of the end of the line (1-based), without the line break.
+func (d *document) lineEnd(line int) int {
+	line = min(max(line, 1), d.lineCount())
+
+	if line < d.lineCount() {
+		end := d.lineStarts[line] - 1
+		if end > 0 && d.content[end-1] == '\r' {
+			end--
+		}
+
+		return end
+	}
+
+	return len(d.content)
+}
+
+// offset returns the offset of a line (1-based) and a column (1-based, in bytes).
+// The column 0 is the beginning of the line.
+func (d *document) offset(line, column int) int {
+	start := d.lineStart(line)
+
+	return min(start+max(column-1, 0), d.lineEnd(line))
+}
+
+// position returns the LSP position of an offset.
+func (d *document) position(offset int) position {
+	offset = min(max(offset, 0), len(d.content))
+
+	// The last line starting before or at the offset.
+	line := sort.SearchInts(d.lineStarts, offset+1) - 1
+
+	return position{Line: line, Character: utf16Len(d.content[d.lineStarts[line]:offset])}
+}
+
+// utf16Len returns the number of UTF-16 code units of the text.
+func utf16Len(text []byte) int {
+	n := 0
+	for len(text) > 0 {
+		r, size := utf8.DecodeRune(text)
+		n += len(utf16.Encode([]rune{r}))
+		text = text[size:]
+	}
+
+	return n
+}
+
+func uriToPath(uri string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+
+	path := u.Path
+
+	// file:///C:/foo
+	if runtime.GOOS == "windows" {
+		path = strings.TrimPrefix(path, "/")
+	}
+
+	return filepath.FromSlash(path), nil
+}
+
+func pathToURI(path string) string {
+	path = filepath.ToSlash(path)
+
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	return (&url.URL{Scheme: "file", Path: path}).String()
+}
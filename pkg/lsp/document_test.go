@@ -0,0 +1,57 @@
+package lsp
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_document_position(t *testing.T) {
+	// "é" is 2 bytes and 1 UTF-16 code unit, "😀" is 4 bytes and 2 UTF-16 code units.
+	doc := newDocument([]byte("package a\n\nvar s = \"é😀\" // x\r\nvar b = 1"))
+
+	testCases := []struct {
+		desc     string
+		offset   int
+		expected position
+	}{
+		{desc: "beginning", offset: 0, expected: position{Line: 0, Character: 0}},
+		{desc: "empty line", offset: 10, expected: position{Line: 1, Character: 0}},
+		{desc: "after multibyte characters", offset: 11 + 16, expected: position{Line: 2, Character: 13}},
+		{desc: "last line", offset: 34 + 4, expected: position{Line: 3, Character: 4}},
+		{desc: "after the end", offset: 1000, expected: position{Line: 3, Character: 9}},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, doc.position(test.offset))
+		})
+	}
+}
+
+func Test_document_offset(t *testing.T) {
+	doc := newDocument([]byte("package a\r\n\nvar a = 1\n"))
+
+	assert.Equal(t, 0, doc.offset(1, 0))
+	assert.Equal(t, 8, doc.offset(1, 9))
+	assert.Equal(t, 9, doc.offset(1, 100), "the offset is limited to the end of the line")
+	assert.Equal(t, 11, doc.offset(2, 1))
+	assert.Equal(t, 17, doc.offset(3, 6))
+	assert.Equal(t, 22, doc.offset(4, 1))
+}
+
+func Test_uriToPath(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "foo bar", "a.go")
+
+	uri := pathToURI(path)
+
+	actual, err := uriToPath(uri)
+	require.NoError(t, err)
+
+	assert.Equal(t, path, actual)
+}
@@ -0,0 +1,68 @@
+package lsp
+
+import (
+	"go/scanner"
+	"go/token"
+	"regexp"
+	"strings"
+)
+
+// The same pattern as the nolint processor.
+var nolintPattern = regexp.MustCompile(`^nolint( |:|$)`)
+
+// nolintEdit returns the edit suppressing the issues of the linter on the line (1-based).
+// The linter is added to the nolint directive of the line, if any.
+// A comment ending the line becomes the reason of the new directive.
+// There is no edit if the issues of the linter are already suppressed on the line.
+func nolintEdit(doc *document, line int, linter string) (textEdit, bool) {
+	start, end := doc.lineStart(line), doc.lineEnd(line)
+	src := doc.content[start:end]
+
+	fset := token.NewFileSet()
+	file := fset.AddFile("", -1, len(src))
+
+	var s scanner.Scanner
+	s.Init(file, src, nil, scanner.ScanComments)
+
+	insertAt := func(offset int, text string) (textEdit, bool) {
+		pos := doc.position(start + offset)
+		return textEdit{Range: textRange{Start: pos, End: pos}, NewText: text}, true
+	}
+
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+
+		if tok != token.COMMENT || !strings.HasPrefix(lit, "//") {
+			continue
+		}
+
+		offset := file.Offset(pos)
+
+		text := strings.TrimLeft(lit, "/ ")
+		if !nolintPattern.MatchString(text) {
+			// A line comment ends the line.
+			return insertAt(offset, "//nolint:"+linter+" ")
+		}
+
+		if strings.HasPrefix(text, "nolint:all") || !strings.HasPrefix(text, "nolint:") {
+			return textEdit{}, false
+		}
+
+		list := strings.TrimRight(strings.Split(strings.TrimPrefix(text, "nolint:"), "//")[0], " \t")
+
+		for _, item := range strings.Split(list, ",") {
+			if name := strings.ToLower(strings.TrimSpace(item)); name == "all" || name == strings.ToLower(linter) {
+				return textEdit{}, false
+			}
+		}
+
+		listOffset := offset + len(lit) - len(text) + len("nolint:")
+
+		return insertAt(listOffset+len(list), ","+linter)
+	}
+
+	return insertAt(len(src), " //nolint:"+linter)
+}
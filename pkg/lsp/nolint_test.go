@@ -0,0 +1,82 @@
+package lsp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_nolintEdit(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		line     string
+		expected *textEdit
+	}{
+		{
+			desc:     "no comment",
+			line:     "\tfoo()",
+			expected: &textEdit{Range: emptyRange(1, 6), NewText: " //nolint:errcheck"},
+		},
+		{
+			desc:     "comment",
+			line:     "\tfoo() // bar",
+			expected: &textEdit{Range: emptyRange(1, 7), NewText: "//nolint:errcheck "},
+		},
+		{
+			desc:     "comment markers in a string",
+			line:     "\tfoo(\"//nolint\")",
+			expected: &textEdit{Range: emptyRange(1, 16), NewText: " //nolint:errcheck"},
+		},
+		{
+			desc:     "directive for another linter",
+			line:     "\tfoo() //nolint:gosec",
+			expected: &textEdit{Range: emptyRange(1, 21), NewText: ",errcheck"},
+		},
+		{
+			desc:     "directive with a reason",
+			line:     "\tfoo() //nolint:gosec,revive // bar",
+			expected: &textEdit{Range: emptyRange(1, 28), NewText: ",errcheck"},
+		},
+		{
+			desc: "directive for the linter",
+			line: "\tfoo() //nolint:gosec,errcheck",
+		},
+		{
+			desc: "directive for all the linters",
+			line: "\tfoo() //nolint",
+		},
+		{
+			desc: "directive for all the linters with a reason",
+			line: "\tfoo() //nolint // bar",
+		},
+		{
+			desc: "nolint:all",
+			line: "\tfoo() //nolint:all",
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			doc := newDocument([]byte("package foo\n" + test.line + "\n}\n"))
+
+			edit, ok := nolintEdit(doc, 2, "errcheck")
+			if test.expected == nil {
+				assert.False(t, ok)
+				return
+			}
+
+			assert.True(t, ok)
+			assert.Equal(t, *test.expected, edit)
+		})
+	}
+}
+
+func emptyRange(line, character int) textRange {
+	return textRange{
+		Start: position{Line: line, Character: character},
+		End:   position{Line: line, Character: character},
+	}
+}
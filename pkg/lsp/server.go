@@ -0,0 +1,511 @@
+// Package lsp implements a language server publishing the issues of the linters as diagnostics.
+//
+// The server speaks the Language Server Protocol over stdio:
+//   - the packages of a document are analyzed when the document is opened or saved,
+//   - the content of the open documents is given to the package loader as overlay,
+//   - the fixes of the issues and the insertion of nolint directives are proposed as code actions.
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+	"github.com/snowmerak/golangci-lint/pkg/result/processors"
+)
+
+const serverName = "golangci-lint"
+
+// Linter lints the packages matching the arguments.
+// The overlay contains the contents of the open documents, by absolute path.
+type Linter func(ctx context.Context, args []string, overlay map[string][]byte) ([]result.Issue, error)
+
+// Server answers the requests of a client.
+type Server struct {
+	log     logutils.Log
+	workDir string
+	version string
+	linter  Linter
+
+	conn *conn
+
+	mu          sync.Mutex
+	initialized bool
+	shutdown    bool
+	documents   map[string][]byte         // the content of the open documents, by absolute path
+	issues      map[string][]result.Issue // the published issues, by absolute path
+
+	// The analyses are run one at a time.
+	lintMu sync.Mutex
+	lintWg sync.WaitGroup
+}
+
+// NewServer creates a server.
+// The paths of the issues are relative to the working directory.
+func NewServer(log logutils.Log, workDir, version string, linter Linter) *Server {
+	return &Server{
+		log:       log,
+		workDir:   workDir,
+		version:   version,
+		linter:    linter,
+		documents: map[string][]byte{},
+		issues:    map[string][]result.Issue{},
+	}
+}
+
+// Serve answers the messages read from r until the exit notification or the end of r.
+func (s *Server) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	defer s.lintWg.Wait()
+
+	// The running analyses are canceled at the exit.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	s.conn = newConn(r, w)
+
+	for {
+		msg, err := s.conn.read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			var respErr *responseError
+			if errors.As(err, &respErr) {
+				s.log.Warnf("Invalid message: %v", err)
+				continue
+			}
+
+			return fmt.Errorf("failed to read message: %w", err)
+		}
+
+		if msg.Method == "exit" {
+			return nil
+		}
+
+		res, err := s.handle(ctx, msg)
+
+		if msg.isNotification() {
+			if err != nil {
+				s.log.Warnf("Failed to handle %s: %v", msg.Method, err)
+			}
+
+			continue
+		}
+
+		if err = s.conn.reply(msg.ID, res, err); err != nil {
+			return fmt.Errorf("failed to write response: %w", err)
+		}
+	}
+}
+
+func (s *Server) handle(ctx context.Context, msg *message) (any, error) {
+	s.mu.Lock()
+	initialized, shutdown := s.initialized, s.shutdown
+	s.mu.Unlock()
+
+	switch {
+	case msg.Method == "initialize":
+		return s.initialize()
+
+	case !initialized:
+		return nil, &responseError{Code: codeServerNotInitialized, Message: "the server is not initialized"}
+
+	case shutdown:
+		return nil, &responseError{Code: codeInvalidRequest, Message: "the server is shut down"}
+	}
+
+	switch msg.Method {
+	case "initialized":
+		return nil, nil
+
+	case "shutdown":
+		s.mu.Lock()
+		s.shutdown = true
+		s.mu.Unlock()
+
+		return nil, nil
+
+	case "textDocument/didOpen":
+		var params didOpenTextDocumentParams
+		return nil, s.withParams(msg, &params, func() error {
+			return s.didOpen(ctx, &params)
+		})
+
+	case "textDocument/didChange":
+		var params didChangeTextDocumentParams
+		return nil, s.withParams(msg, &params, func() error {
+			return s.didChange(&params)
+		})
+
+	case "textDocument/didSave":
+		var params didSaveTextDocumentParams
+		return nil, s.withParams(msg, &params, func() error {
+			return s.didSave(ctx, &params)
+		})
+
+	case "textDocument/didClose":
+		var params didCloseTextDocumentParams
+		return nil, s.withParams(msg, &params, func() error {
+			return s.didClose(&params)
+		})
+
+	case "textDocument/codeAction":
+		var params codeActionParams
+		var actions []codeAction
+		err := s.withParams(msg, &params, func() error {
+			var err error
+			actions, err = s.codeActions(&params)
+			return err
+		})
+
+		return actions, err
+
+	default:
+		if msg.isNotification() {
+			// The notifications not supported by the server are ignored (ex: $/cancelRequest).
+			return nil, nil
+		}
+
+		return nil, &responseError{Code: codeMethodNotFound, Message: fmt.Sprintf("method not supported: %s", msg.Method)}
+	}
+}
+
+func (*Server) withParams(msg *message, params any, fn func() error) error {
+	if err := json.Unmarshal(msg.Params, params); err != nil {
+		return &responseError{Code: codeInvalidParams, Message: err.Error()}
+	}
+
+	return fn()
+}
+
+func (s *Server) initialize() (any, error) {
+	s.mu.Lock()
+	s.initialized = true
+	s.mu.Unlock()
+
+	return initializeResult{
+		Capabilities: serverCapabilities{
+			TextDocumentSync: textDocumentSyncOptions{
+				OpenClose: true,
+				Change:    textDocumentSyncFull,
+				Save:      saveOptions{IncludeText: true},
+			},
+			CodeActionProvider: codeActionOptions{CodeActionKinds: []string{codeActionQuickFix}},
+		},
+		ServerInfo: serverInfo{Name: serverName, Version: s.version},
+	}, nil
+}
+
+func (s *Server) didOpen(ctx context.Context, params *didOpenTextDocumentParams) error {
+	path, err := uriToPath(params.TextDocument.URI)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.documents[path] = []byte(params.TextDocument.Text)
+	s.mu.Unlock()
+
+	s.lint(ctx, path)
+
+	return nil
+}
+
+func (s *Server) didChange(params *didChangeTextDocumentParams) error {
+	path, err := uriToPath(params.TextDocument.URI)
+	if err != nil {
+		return err
+	}
+
+	if len(params.ContentChanges) == 0 {
+		return nil
+	}
+
+	// Full synchronization: the last change contains the whole document.
+	s.mu.Lock()
+	s.documents[path] = []byte(params.ContentChanges[len(params.ContentChanges)-1].Text)
+	s.mu.Unlock()
+
+	return nil
+}
+
+func (s *Server) didSave(ctx context.Context, params *didSaveTextDocumentParams) error {
+	path, err := uriToPath(params.TextDocument.URI)
+	if err != nil {
+		return err
+	}
+
+	if params.Text != nil {
+		s.mu.Lock()
+		s.documents[path] = []byte(*params.Text)
+		s.mu.Unlock()
+	}
+
+	s.lint(ctx, path)
+
+	return nil
+}
+
+func (s *Server) didClose(params *didCloseTextDocumentParams) error {
+	path, err := uriToPath(params.TextDocument.URI)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	delete(s.documents, path)
+	delete(s.issues, path)
+	s.mu.Unlock()
+
+	return s.publish(path, nil)
+}
+
+// lint analyzes the package of the file in the background, then publishes the diagnostics of the files of the package.
+func (s *Server) lint(ctx context.Context, path string) {
+	s.lintWg.Add(1)
+
+	go func() {
+		defer s.lintWg.Done()
+
+		s.lintMu.Lock()
+		defer s.lintMu.Unlock()
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		dir := filepath.Dir(path)
+
+		s.mu.Lock()
+		overlay := make(map[string][]byte, len(s.documents))
+		for file, content := range s.documents {
+			overlay[file] = content
+		}
+		s.mu.Unlock()
+
+		issues, err := s.linter(ctx, []string{dir}, overlay)
+		if err != nil {
+			s.log.Warnf("Failed to lint %s: %v", dir, err)
+
+			_ = s.conn.notify("window/logMessage", logMessageParams{
+				Type:    messageTypeError,
+				Message: fmt.Sprintf("%s: failed to lint %s: %v", serverName, dir, err),
+			})
+
+			return
+		}
+
+		if err = s.publishDir(dir, issues); err != nil {
+			s.log.Warnf("Failed to publish the diagnostics: %v", err)
+		}
+	}()
+}
+
+// publishDir replaces the diagnostics of the files of the directory.
+func (s *Server) publishDir(dir string, issues []result.Issue) error {
+	byFile := map[string][]result.Issue{}
+	for i := range issues {
+		path := s.absPath(issues[i].FilePath())
+		if filepath.Dir(path) != dir {
+			continue
+		}
+
+		byFile[path] = append(byFile[path], issues[i])
+	}
+
+	s.mu.Lock()
+	var files []string
+	for path := range s.issues {
+		if filepath.Dir(path) == dir {
+			files = append(files, path)
+			delete(s.issues, path)
+		}
+	}
+	for path, fileIssues := range byFile {
+		if _, ok := s.issues[path]; !ok {
+			files = append(files, path)
+		}
+		s.issues[path] = fileIssues
+	}
+	s.mu.Unlock()
+
+	sort.Strings(files)
+
+	for _, path := range files {
+		if err := s.publish(path, byFile[path]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) publish(path string, issues []result.Issue) error {
+	params := publishDiagnosticsParams{
+		URI:         pathToURI(path),
+		Diagnostics: []diagnostic{},
+	}
+
+	if len(issues) > 0 {
+		doc := newDocument(s.content(path))
+
+		for i := range issues {
+			params.Diagnostics = append(params.Diagnostics, toDiagnostic(doc, &issues[i]))
+		}
+	}
+
+	return s.conn.notify("textDocument/publishDiagnostics", params)
+}
+
+func (s *Server) codeActions(params *codeActionParams) ([]codeAction, error) {
+	path, err := uriToPath(params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	issues := s.issues[path]
+	s.mu.Unlock()
+
+	actions := []codeAction{}
+
+	if len(issues) == 0 {
+		return actions, nil
+	}
+
+	doc := newDocument(s.content(path))
+	uri := params.TextDocument.URI
+
+	for i := range issues {
+		issue := &issues[i]
+
+		diag := toDiagnostic(doc, issue)
+		if diag.Range.End.Line < params.Range.Start.Line || diag.Range.Start.Line > params.Range.End.Line {
+			continue
+		}
+
+		if issue.Replacement != nil {
+			if action, err := fixAction(doc, uri, issue, diag); err != nil {
+				s.log.Warnf("Invalid fix of %s: %v", issue.FromLinter, err)
+			} else {
+				actions = append(actions, *action)
+			}
+		}
+
+		if action := nolintAction(doc, uri, issue, diag); action != nil {
+			actions = append(actions, *action)
+		}
+	}
+
+	return actions, nil
+}
+
+// content returns the content of the open document, or the content of the file.
+func (s *Server) content(path string) []byte {
+	s.mu.Lock()
+	content, ok := s.documents[path]
+	s.mu.Unlock()
+
+	if ok {
+		return content
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		s.log.Warnf("Failed to read %s: %v", path, err)
+	}
+
+	return content
+}
+
+func (s *Server) absPath(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(s.workDir, path)
+}
+
+func toDiagnostic(doc *document, issue *result.Issue) diagnostic {
+	lineRange := issue.GetLineRange()
+
+	var rng textRange
+	if issue.Column() > 0 && lineRange.From == lineRange.To {
+		// An empty range: the clients highlight the word at the position.
+		pos := doc.position(doc.offset(issue.Line(), issue.Column()))
+		rng = textRange{Start: pos, End: pos}
+	} else {
+		rng = textRange{
+			Start: doc.position(doc.lineStart(lineRange.From)),
+			End:   doc.position(doc.lineEnd(lineRange.To)),
+		}
+	}
+
+	return diagnostic{
+		Range:    rng,
+		Severity: toSeverity(issue.Severity),
+		Code:     issue.RuleID,
+		Source:   issue.FromLinter,
+		Message:  issue.Text,
+	}
+}
+
+func toSeverity(severity string) int {
+	switch strings.ToLower(severity) {
+	case "error":
+		return severityError
+	case "info", "information":
+		return severityInformation
+	case "hint":
+		return severityHint
+	default:
+		return severityWarning
+	}
+}
+
+func fixAction(doc *document, uri string, issue *result.Issue, diag diagnostic) (*codeAction, error) {
+	edits, err := processors.ReplacementTextEdits(issue, doc.content)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]textEdit, 0, len(edits))
+	for _, edit := range edits {
+		changes = append(changes, textEdit{
+			Range:   textRange{Start: doc.position(edit.Pos), End: doc.position(edit.End)},
+			NewText: edit.NewText,
+		})
+	}
+
+	return &codeAction{
+		Title:       fmt.Sprintf("Apply the fix of %s", issue.CheckName()),
+		Kind:        codeActionQuickFix,
+		Diagnostics: []diagnostic{diag},
+		IsPreferred: true,
+		Edit:        workspaceEdit{Changes: map[string][]textEdit{uri: changes}},
+	}, nil
+}
+
+func nolintAction(doc *document, uri string, issue *result.Issue, diag diagnostic) *codeAction {
+	edit, ok := nolintEdit(doc, issue.Line(), issue.FromLinter)
+	if !ok {
+		return nil
+	}
+
+	return &codeAction{
+		Title:       fmt.Sprintf("Suppress with //nolint:%s", issue.FromLinter),
+		Kind:        codeActionQuickFix,
+		Diagnostics: []diagnostic{diag},
+		Edit:        workspaceEdit{Changes: map[string][]textEdit{uri: {edit}}},
+	}
+}
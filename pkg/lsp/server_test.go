@@ -0,0 +1,276 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+const testFileContent = "package a\n\nfunc A() {\n\tfoo()\n}\n"
+
+func TestServer_diagnostics(t *testing.T) {
+	workDir := t.TempDir()
+
+	path := filepath.Join(workDir, "a.go")
+	require.NoError(t, os.WriteFile(path, []byte("package a\n"), 0o600))
+
+	var (
+		receivedArgs    []string
+		receivedOverlay map[string][]byte
+	)
+
+	client := startServer(t, workDir, func(_ context.Context, args []string, overlay map[string][]byte) ([]result.Issue, error) {
+		receivedArgs, receivedOverlay = args, overlay
+
+		return []result.Issue{
+			{
+				FromLinter: "linter-a",
+				Text:       "some issue",
+				Severity:   "error",
+				RuleID:     "A001",
+				Pos:        token.Position{Filename: "a.go", Line: 4, Column: 2},
+			},
+			{
+				FromLinter: "linter-b",
+				Text:       "another issue",
+				Pos:        token.Position{Filename: "a.go", Line: 3},
+			},
+			{
+				FromLinter: "linter-b",
+				Text:       "issue in another directory",
+				Pos:        token.Position{Filename: filepath.Join("b", "b.go"), Line: 1},
+			},
+		}, nil
+	})
+
+	client.initialize()
+
+	uri := pathToURI(path)
+
+	client.notify("textDocument/didOpen", didOpenTextDocumentParams{
+		TextDocument: textDocumentItem{URI: uri, Text: testFileContent},
+	})
+
+	diagnostics := client.waitDiagnostics()
+	assert.Equal(t, uri, diagnostics.URI)
+
+	expected := []diagnostic{
+		{
+			Range:    emptyRange(3, 1),
+			Severity: severityError,
+			Code:     "A001",
+			Source:   "linter-a",
+			Message:  "some issue",
+		},
+		{
+			Range: textRange{
+				Start: position{Line: 2, Character: 0},
+				End:   position{Line: 2, Character: 10},
+			},
+			Severity: severityWarning,
+			Source:   "linter-b",
+			Message:  "another issue",
+		},
+	}
+	assert.Equal(t, expected, diagnostics.Diagnostics)
+
+	assert.Equal(t, []string{workDir}, receivedArgs)
+	assert.Equal(t, map[string][]byte{path: []byte(testFileContent)}, receivedOverlay)
+
+	client.notify("textDocument/didClose", didCloseTextDocumentParams{TextDocument: textDocumentIdentifier{URI: uri}})
+
+	diagnostics = client.waitDiagnostics()
+	assert.Equal(t, uri, diagnostics.URI)
+	assert.Empty(t, diagnostics.Diagnostics)
+}
+
+func TestServer_codeActions(t *testing.T) {
+	workDir := t.TempDir()
+
+	path := filepath.Join(workDir, "a.go")
+
+	client := startServer(t, workDir, func(_ context.Context, _ []string, _ map[string][]byte) ([]result.Issue, error) {
+		return []result.Issue{
+			{
+				FromLinter: "linter-a",
+				Text:       "some issue",
+				Pos:        token.Position{Filename: "a.go", Line: 4, Column: 2},
+				Replacement: &result.Replacement{
+					Inline: &result.InlineFix{StartCol: 1, Length: 3, NewString: "bar"},
+				},
+			},
+			{
+				FromLinter: "linter-b",
+				Text:       "another issue",
+				Pos:        token.Position{Filename: "a.go", Line: 1},
+			},
+		}, nil
+	})
+
+	client.initialize()
+
+	uri := pathToURI(path)
+
+	client.notify("textDocument/didOpen", didOpenTextDocumentParams{
+		TextDocument: textDocumentItem{URI: uri, Text: testFileContent},
+	})
+
+	client.waitDiagnostics()
+
+	resp := client.request("textDocument/codeAction", codeActionParams{
+		TextDocument: textDocumentIdentifier{URI: uri},
+		Range:        emptyRange(3, 0),
+	})
+	require.Nil(t, resp.Error)
+
+	var actions []codeAction
+	require.NoError(t, json.Unmarshal(resp.Result, &actions))
+
+	require.Len(t, actions, 2)
+
+	assert.Equal(t, "Apply the fix of linter-a", actions[0].Title)
+	assert.Equal(t, codeActionQuickFix, actions[0].Kind)
+	assert.True(t, actions[0].IsPreferred)
+	assert.Equal(t, map[string][]textEdit{uri: {{
+		Range: textRange{
+			Start: position{Line: 3, Character: 1},
+			End:   position{Line: 3, Character: 4},
+		},
+		NewText: "bar",
+	}}}, actions[0].Edit.Changes)
+
+	assert.Equal(t, "Suppress with //nolint:linter-a", actions[1].Title)
+	assert.Equal(t, map[string][]textEdit{uri: {{
+		Range:   emptyRange(3, 6),
+		NewText: " //nolint:linter-a",
+	}}}, actions[1].Edit.Changes)
+}
+
+func TestServer_notInitialized(t *testing.T) {
+	client := startServer(t, t.TempDir(), func(_ context.Context, _ []string, _ map[string][]byte) ([]result.Issue, error) {
+		return nil, nil
+	})
+
+	resp := client.request("textDocument/codeAction", codeActionParams{})
+	require.NotNil(t, resp.Error)
+
+	assert.Equal(t, codeServerNotInitialized, resp.Error.Code)
+}
+
+type testClient struct {
+	t    *testing.T
+	conn *conn
+
+	lastID int
+
+	responses     chan *message
+	notifications chan *message
+}
+
+func startServer(t *testing.T, workDir string, linter Linter) *testClient {
+	t.Helper()
+
+	clientReader, serverWriter := io.Pipe()
+	serverReader, clientWriter := io.Pipe()
+
+	client := &testClient{
+		t:             t,
+		conn:          newConn(clientReader, clientWriter),
+		responses:     make(chan *message, 10),
+		notifications: make(chan *message, 10),
+	}
+
+	done := make(chan error)
+	go func() {
+		done <- NewServer(logutils.NewStderrLog("skip"), workDir, "test", linter).
+			Serve(context.Background(), serverReader, serverWriter)
+	}()
+
+	go func() {
+		for {
+			msg, err := client.conn.read()
+			if err != nil {
+				return
+			}
+
+			if msg.isNotification() {
+				client.notifications <- msg
+			} else {
+				client.responses <- msg
+			}
+		}
+	}()
+
+	t.Cleanup(func() {
+		client.notify("exit", nil)
+
+		require.NoError(t, <-done)
+
+		_ = serverWriter.Close()
+		_ = clientWriter.Close()
+	})
+
+	return client
+}
+
+func (c *testClient) initialize() {
+	resp := c.request("initialize", map[string]any{})
+	require.Nil(c.t, resp.Error)
+
+	c.notify("initialized", map[string]any{})
+}
+
+func (c *testClient) request(method string, params any) *message {
+	c.t.Helper()
+
+	c.lastID++
+
+	require.NoError(c.t, c.conn.write(map[string]any{"jsonrpc": "2.0", "id": c.lastID, "method": method, "params": params}))
+
+	select {
+	case resp := <-c.responses:
+		return resp
+	case <-time.After(10 * time.Second):
+		require.FailNow(c.t, "no response to "+method)
+		return nil
+	}
+}
+
+func (c *testClient) notify(method string, params any) {
+	c.t.Helper()
+
+	require.NoError(c.t, c.conn.notify(method, params))
+}
+
+func (c *testClient) waitDiagnostics() *publishDiagnosticsParams {
+	c.t.Helper()
+
+	for {
+		select {
+		case msg := <-c.notifications:
+			if msg.Method != "textDocument/publishDiagnostics" {
+				continue
+			}
+
+			params := &publishDiagnosticsParams{}
+			require.NoError(c.t, json.Unmarshal(msg.Params, params))
+
+			return params
+
+		case <-time.After(10 * time.Second):
+			require.FailNow(c.t, "no diagnostics")
+			return nil
+		}
+	}
+}
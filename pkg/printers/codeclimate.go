@@ -3,49 +3,144 @@ package printers
 import (
 	"encoding/json"
 	"io"
+	"slices"
+	"strings"
 
+	"github.com/snowmerak/golangci-lint/pkg/lint/linter"
+	"github.com/snowmerak/golangci-lint/pkg/report"
 	"github.com/snowmerak/golangci-lint/pkg/result"
 )
 
 const defaultCodeClimateSeverity = "critical"
 
-// CodeClimateIssue is a subset of the Code Climate spec.
+// The severities of the Code Climate spec, from the lowest to the highest.
+const (
+	codeClimateSeverityInfo     = "info"
+	codeClimateSeverityMinor    = "minor"
+	codeClimateSeverityMajor    = "major"
+	codeClimateSeverityCritical = "critical"
+	codeClimateSeverityBlocker  = "blocker"
+)
+
+// The categories of the Code Climate spec.
+const (
+	codeClimateCategoryBugRisk     = "Bug Risk"
+	codeClimateCategoryClarity     = "Clarity"
+	codeClimateCategoryComplexity  = "Complexity"
+	codeClimateCategoryDuplication = "Duplication"
+	codeClimateCategoryPerformance = "Performance"
+	codeClimateCategorySecurity    = "Security"
+	codeClimateCategoryStyle       = "Style"
+)
+
+// codeClimateSeverities maps the usual severity names onto the severities of the spec.
+// The severities of the spec are used as is.
+var codeClimateSeverities = map[string]string{
+	"fatal":   codeClimateSeverityBlocker,
+	"error":   codeClimateSeverityCritical,
+	"high":    codeClimateSeverityCritical,
+	"warning": codeClimateSeverityMajor,
+	"medium":  codeClimateSeverityMajor,
+	"low":     codeClimateSeverityMinor,
+	"note":    codeClimateSeverityInfo,
+	"hint":    codeClimateSeverityInfo,
+	"none":    codeClimateSeverityInfo,
+
+	codeClimateSeverityInfo:     codeClimateSeverityInfo,
+	codeClimateSeverityMinor:    codeClimateSeverityMinor,
+	codeClimateSeverityMajor:    codeClimateSeverityMajor,
+	codeClimateSeverityCritical: codeClimateSeverityCritical,
+	codeClimateSeverityBlocker:  codeClimateSeverityBlocker,
+}
+
+// codeClimatePresetCategories maps the presets of the linters onto the categories of the spec.
+var codeClimatePresetCategories = map[string]string{
+	linter.PresetBugs:        codeClimateCategoryBugRisk,
+	linter.PresetError:       codeClimateCategoryBugRisk,
+	linter.PresetComplexity:  codeClimateCategoryComplexity,
+	linter.PresetPerformance: codeClimateCategoryPerformance,
+	linter.PresetStyle:       codeClimateCategoryStyle,
+	linter.PresetFormatting:  codeClimateCategoryStyle,
+	linter.PresetImport:      codeClimateCategoryStyle,
+	linter.PresetComment:     codeClimateCategoryStyle,
+	linter.PresetUnused:      codeClimateCategoryClarity,
+}
+
+// codeClimateLinterCategories contains the categories that can't be deduced from the presets of the linters.
+var codeClimateLinterCategories = map[string]string{
+	"gosec": codeClimateCategorySecurity,
+	"dupl":  codeClimateCategoryDuplication,
+}
+
+// CodeClimateIssue is an issue of the Code Climate spec.
 // https://github.com/codeclimate/platform/blob/master/spec/analyzers/SPEC.md#data-types
-// It is just enough to support GitLab CI Code Quality.
-// https://docs.gitlab.com/ee/user/project/merge_requests/code_quality.html
+// It is also the format of GitLab CI Code Quality.
+// https://docs.gitlab.com/ee/ci/testing/code_quality.html#implement-a-custom-tool
 type CodeClimateIssue struct {
-	Description string `json:"description"`
-	Severity    string `json:"severity,omitempty"`
-	Fingerprint string `json:"fingerprint"`
-	Location    struct {
-		Path  string `json:"path"`
-		Lines struct {
-			Begin int `json:"begin"`
-		} `json:"lines"`
-	} `json:"location"`
+	Type        string              `json:"type"`
+	Description string              `json:"description"`
+	CheckName   string              `json:"check_name"`
+	Categories  []string            `json:"categories"`
+	Content     *CodeClimateContent `json:"content,omitempty"`
+	Severity    string              `json:"severity,omitempty"`
+	Fingerprint string              `json:"fingerprint"`
+	Location    CodeClimateLocation `json:"location"`
+}
+
+// CodeClimateContent is the Markdown explanation of an issue.
+type CodeClimateContent struct {
+	Body string `json:"body"`
+}
+
+type CodeClimateLocation struct {
+	Path      string               `json:"path"`
+	Positions CodeClimatePositions `json:"positions"`
+}
+
+type CodeClimatePositions struct {
+	Begin CodeClimatePosition `json:"begin"`
+	End   CodeClimatePosition `json:"end"`
+}
+
+// CodeClimatePosition is a position with 1-based line and column.
+type CodeClimatePosition struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
 }
 
 type CodeClimate struct {
-	w io.Writer
+	rd *report.Data
+	w  io.Writer
 }
 
-func NewCodeClimate(w io.Writer) *CodeClimate {
-	return &CodeClimate{w: w}
+func NewCodeClimate(rd *report.Data, w io.Writer) *CodeClimate {
+	return &CodeClimate{rd: rd, w: w}
 }
 
 func (p CodeClimate) Print(issues []result.Issue) error {
+	sources := sourceFiles{}
+
 	codeClimateIssues := make([]CodeClimateIssue, 0, len(issues))
 	for i := range issues {
 		issue := &issues[i]
-		codeClimateIssue := CodeClimateIssue{}
-		codeClimateIssue.Description = issue.Description()
-		codeClimateIssue.Location.Path = issue.Pos.Filename
-		codeClimateIssue.Location.Lines.Begin = issue.Pos.Line
-		codeClimateIssue.Fingerprint = issue.Fingerprint()
-		codeClimateIssue.Severity = defaultCodeClimateSeverity
-
-		if issue.Severity != "" {
-			codeClimateIssue.Severity = issue.Severity
+
+		codeClimateIssue := CodeClimateIssue{
+			Type:        "issue",
+			Description: issue.Description(),
+			CheckName:   issue.CheckName(),
+			Categories:  p.categories(issue),
+			Severity:    codeClimateSeverity(issue.Severity),
+			Fingerprint: issue.Fingerprint(),
+			Location: CodeClimateLocation{
+				Path:      issue.FilePath(),
+				Positions: codeClimatePositions(issue),
+			},
+		}
+
+		if fix := sources.fix(issue); fix != "" {
+			codeClimateIssue.Content = &CodeClimateContent{
+				Body: "Suggested fix:\n\n```diff\n" + strings.TrimSuffix(fix, "\n") + "\n```\n",
+			}
 		}
 
 		codeClimateIssues = append(codeClimateIssues, codeClimateIssue)
@@ -57,3 +152,58 @@ func (p CodeClimate) Print(issues []result.Issue) error {
 	}
 	return nil
 }
+
+// categories returns the categories of the issue, deduced from its linter.
+// The spec requires at least one category: an unknown linter reports bug risks.
+func (p CodeClimate) categories(issue *result.Issue) []string {
+	if category, ok := codeClimateLinterCategories[issue.FromLinter]; ok {
+		return []string{category}
+	}
+
+	var categories []string
+
+	if ld := p.rd.GetLinter(issue.FromLinter); ld != nil {
+		for _, preset := range ld.Presets {
+			category, ok := codeClimatePresetCategories[preset]
+			if ok && !slices.Contains(categories, category) {
+				categories = append(categories, category)
+			}
+		}
+	}
+
+	if len(categories) == 0 {
+		return []string{codeClimateCategoryBugRisk}
+	}
+
+	return categories
+}
+
+// codeClimateSeverity maps the severity of the issue onto the severities of the spec.
+func codeClimateSeverity(severity string) string {
+	if s, ok := codeClimateSeverities[strings.ToLower(severity)]; ok {
+		return s
+	}
+
+	return defaultCodeClimateSeverity
+}
+
+// codeClimatePositions returns the positions of the issue.
+// The end column is only known for the inline fixes:
+// otherwise, the issue ends at the end of its last source line, or at its beginning.
+func codeClimatePositions(issue *result.Issue) CodeClimatePositions {
+	rng := issue.GetLineRange()
+
+	begin := CodeClimatePosition{Line: issue.Line(), Column: max(1, issue.Column())}
+	end := CodeClimatePosition{Line: max(begin.Line, rng.To), Column: begin.Column}
+
+	switch {
+	case issue.Replacement != nil && issue.Replacement.Inline != nil && end.Line == begin.Line:
+		inline := issue.Replacement.Inline
+		end.Column = max(begin.Column, inline.StartCol+inline.Length+1)
+
+	case end.Line > begin.Line && len(issue.SourceLines) > end.Line-rng.From:
+		end.Column = len(issue.SourceLines[end.Line-rng.From]) + 1
+	}
+
+	return CodeClimatePositions{Begin: begin, End: end}
+}
@@ -2,12 +2,17 @@ package printers
 
 import (
 	"bytes"
+	"encoding/json"
 	"go/token"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/snowmerak/golangci-lint/pkg/lint/linter"
+	"github.com/snowmerak/golangci-lint/pkg/report"
 	"github.com/snowmerak/golangci-lint/pkg/result"
 )
 
@@ -28,6 +33,7 @@ func TestCodeClimate_Print(t *testing.T) {
 			FromLinter: "linter-b",
 			Severity:   "error",
 			Text:       "another issue",
+			RuleID:     "B001",
 			SourceLines: []string{
 				"func foo() {",
 				"\tfmt.Println(\"bar\")",
@@ -58,13 +64,97 @@ func TestCodeClimate_Print(t *testing.T) {
 	}
 
 	buf := new(bytes.Buffer)
-	printer := NewCodeClimate(buf)
+	printer := NewCodeClimate(&report.Data{}, buf)
 
 	err := printer.Print(issues)
 	require.NoError(t, err)
 
-	expected := `[{"description":"linter-a: some issue","severity":"warning","fingerprint":"BA73C5DF4A6FD8462FFF1D3140235777","location":{"path":"path/to/filea.go","lines":{"begin":10}}},{"description":"linter-b: another issue","severity":"error","fingerprint":"0777B4FE60242BD8B2E9B7E92C4B9521","location":{"path":"path/to/fileb.go","lines":{"begin":300}}},{"description":"linter-c: issue c","severity":"critical","fingerprint":"BEE6E9FBB6BFA4B7DB9FB036697FB036","location":{"path":"path/to/filec.go","lines":{"begin":200}}}]
+	expected := `[{"type":"issue","description":"linter-a: some issue","check_name":"linter-a","categories":["Bug Risk"],"severity":"major","fingerprint":"CB5F8896796995E37AE3595392C70FAE","location":{"path":"path/to/filea.go","positions":{"begin":{"line":10,"column":4},"end":{"line":10,"column":4}}}},{"type":"issue","description":"linter-b: another issue","check_name":"linter-b/B001","categories":["Bug Risk"],"severity":"critical","fingerprint":"7BD7AD70E3B2A21119EFFD694529C8CA","location":{"path":"path/to/fileb.go","positions":{"begin":{"line":300,"column":9},"end":{"line":300,"column":9}}}},{"type":"issue","description":"linter-c: issue c","check_name":"linter-c","categories":["Bug Risk"],"severity":"critical","fingerprint":"0CAD7CCADE4E22FDF9F66D39F9646CB7","location":{"path":"path/to/filec.go","positions":{"begin":{"line":200,"column":2},"end":{"line":200,"column":2}}}}]
 `
 
 	assert.Equal(t, expected, buf.String())
 }
+
+func TestCodeClimate_Print_details(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "main.go")
+
+	err := os.WriteFile(filePath, []byte("package main\n\nvar a  = 1\n"), 0o600)
+	require.NoError(t, err)
+
+	data := &report.Data{}
+	data.AddLinter("linter-a", true, false, "", "", []string{linter.PresetStyle, linter.PresetFormatting, linter.PresetComplexity})
+	data.AddLinter("gosec", true, false, "", "", []string{linter.PresetBugs})
+
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Severity:   "Blocker",
+			Text:       "some issue",
+			SourceLines: []string{
+				"var a  = 1",
+			},
+			Replacement: &result.Replacement{
+				Inline: &result.InlineFix{StartCol: 5, Length: 2, NewString: " "},
+			},
+			Pos: token.Position{Filename: filePath, Line: 3, Column: 6},
+		},
+		{
+			FromLinter: "gosec",
+			Severity:   "low",
+			Text:       "another issue",
+			SourceLines: []string{
+				"func foo() {",
+				"\tfmt.Println(\"bar\")",
+				"}",
+			},
+			LineRange: &result.Range{From: 300, To: 302},
+			Pos:       token.Position{Filename: "path/to/fileb.go", Line: 300, Column: 1},
+		},
+		{
+			FromLinter: "linter-c",
+			Severity:   "unknown",
+			Text:       "issue c",
+			Pos:        token.Position{Filename: "path/to/filec.go", Line: 200},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	err = NewCodeClimate(data, buf).Print(issues)
+	require.NoError(t, err)
+
+	var codeClimateIssues []CodeClimateIssue
+
+	err = json.Unmarshal(buf.Bytes(), &codeClimateIssues)
+	require.NoError(t, err)
+
+	require.Len(t, codeClimateIssues, 3)
+
+	issueA := codeClimateIssues[0]
+	assert.Equal(t, "blocker", issueA.Severity)
+	assert.Equal(t, []string{"Style", "Complexity"}, issueA.Categories)
+	assert.Equal(t, CodeClimatePositions{
+		Begin: CodeClimatePosition{Line: 3, Column: 6},
+		End:   CodeClimatePosition{Line: 3, Column: 8},
+	}, issueA.Location.Positions)
+	require.NotNil(t, issueA.Content)
+	assert.Contains(t, issueA.Content.Body, "```diff\n")
+	assert.Contains(t, issueA.Content.Body, "-var a  = 1\n+var a = 1\n")
+
+	issueB := codeClimateIssues[1]
+	assert.Equal(t, "minor", issueB.Severity)
+	assert.Equal(t, []string{"Security"}, issueB.Categories)
+	assert.Equal(t, CodeClimatePositions{
+		Begin: CodeClimatePosition{Line: 300, Column: 1},
+		End:   CodeClimatePosition{Line: 302, Column: 2},
+	}, issueB.Location.Positions)
+	assert.Nil(t, issueB.Content)
+
+	issueC := codeClimateIssues[2]
+	assert.Equal(t, "critical", issueC.Severity)
+	assert.Equal(t, []string{"Bug Risk"}, issueC.Categories)
+	assert.Equal(t, CodeClimatePositions{
+		Begin: CodeClimatePosition{Line: 200, Column: 1},
+		End:   CodeClimatePosition{Line: 200, Column: 1},
+	}, issueC.Location.Positions)
+}
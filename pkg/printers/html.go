@@ -4,122 +4,358 @@ import (
 	"fmt"
 	"html/template"
 	"io"
+	"sort"
 	"strings"
 
 	"github.com/snowmerak/golangci-lint/pkg/result"
 )
 
+// The page is self-contained: the styles and the scripts are embedded, nothing is loaded from the network.
 const templateContent = `<!doctype html>
 <html lang="en">
 <head>
     <meta charset="utf-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1">
     <title>golangci-lint</title>
-    <link rel="shortcut icon" type="image/png" href="https://golangci-lint.run/favicon-32x32.png">
-    <link rel="stylesheet" href="https://cdnjs.cloudflare.com/ajax/libs/bulma/0.9.2/css/bulma.min.css"
-          integrity="sha512-byErQdWdTqREz6DLAA9pCnLbdoGGhXfU6gm1c8bkf7F51JVmUBlayGe2A31VpXWQP+eiJ3ilTAZHCR3vmMyybA=="
-          crossorigin="anonymous" referrerpolicy="no-referrer"/>
-    <link rel="stylesheet" href="https://cdnjs.cloudflare.com/ajax/libs/highlight.js/10.7.2/styles/default.min.css"
-          integrity="sha512-kZqGbhf9JTB4bVJ0G8HCkqmaPcRgo88F0dneK30yku5Y/dep7CZfCnNml2Je/sY4lBoqoksXz4PtVXS4GHSUzQ=="
-          crossorigin="anonymous" referrerpolicy="no-referrer"/>
-    <script type="text/javascript" src="https://cdnjs.cloudflare.com/ajax/libs/highlight.js/10.7.2/highlight.min.js"
-            integrity="sha512-s+tOYYcC3Jybgr9mVsdAxsRYlGNq4mlAurOrfNuGMQ/SCofNPu92tjE7YRZCsdEtWL1yGkqk15fU/ark206YTg=="
-            crossorigin="anonymous" referrerpolicy="no-referrer"></script>
-    <script type="text/javascript" src="https://cdnjs.cloudflare.com/ajax/libs/highlight.js/10.7.2/languages/go.min.js"
-            integrity="sha512-+UYV2NyyynWEQcZ4sMTKmeppyV331gqvMOGZ61/dqc89Tn1H40lF05ACd03RSD9EWwGutNwKj256mIR8waEJBQ=="
-            crossorigin="anonymous" referrerpolicy="no-referrer"></script>
-    <script type="text/javascript" src="https://cdnjs.cloudflare.com/ajax/libs/react/17.0.2/umd/react.production.min.js"
-            integrity="sha512-qlzIeUtTg7eBpmEaS12NZgxz52YYZVF5myj89mjJEesBd/oE9UPsYOX2QAXzvOAZYEvQohKdcY8zKE02ifXDmA=="
-            crossorigin="anonymous" referrerpolicy="no-referrer"></script>
-    <script type="text/javascript"
-            src="https://cdnjs.cloudflare.com/ajax/libs/react-dom/17.0.2/umd/react-dom.production.min.js"
-            integrity="sha512-9jGNr5Piwe8nzLLYTk8QrEMPfjGU0px80GYzKZUxi7lmCfrBjtyCc1V5kkS5vxVwwIB7Qpzc7UxLiQxfAN30dw=="
-            crossorigin="anonymous" referrerpolicy="no-referrer"></script>
-    <script type="text/javascript" src="https://cdnjs.cloudflare.com/ajax/libs/babel-standalone/6.26.0/babel.min.js"
-            integrity="sha512-kp7YHLxuJDJcOzStgd6vtpxr4ZU9kjn77e6dBsivSz+pUuAuMlE2UTdKB7jjsWT84qbS8kdCWHPETnP/ctrFsA=="
-            crossorigin="anonymous" referrerpolicy="no-referrer"></script>
+    <style>
+        * { box-sizing: border-box; }
+        body { margin: 0; font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Roboto, Helvetica, Arial, sans-serif; font-size: 14px; color: #24292f; background: #f6f8fa; }
+        header { padding: 16px 24px; background: #24292f; color: #fff; }
+        header h1 { margin: 0; font-size: 20px; }
+        header p { margin: 4px 0 0; color: #c9d1d9; }
+        main { display: flex; align-items: flex-start; gap: 16px; padding: 16px 24px; }
+        aside { flex: 0 0 300px; position: sticky; top: 16px; max-height: calc(100vh - 32px); overflow: auto; }
+        section.content { flex: 1; min-width: 0; }
+        .panel { background: #fff; border: 1px solid #d0d7de; border-radius: 6px; padding: 12px; margin-bottom: 16px; }
+        .panel h2 { margin: 0 0 8px; font-size: 14px; text-transform: uppercase; color: #57606a; }
+        table.counts { width: 100%; border-collapse: collapse; }
+        table.counts td { padding: 3px 4px; cursor: pointer; }
+        table.counts td.count { text-align: right; font-variant-numeric: tabular-nums; }
+        table.counts tr:hover, table.counts tr.selected, .tree-node:hover, .tree-node.selected { background: #ddf4ff; }
+        .tree-node { padding: 2px 4px; cursor: pointer; white-space: nowrap; overflow: hidden; text-overflow: ellipsis; }
+        .tree-node .count { float: right; color: #57606a; }
+        .filters { display: flex; flex-wrap: wrap; gap: 8px; align-items: center; }
+        .filters input, .filters select, .filters button { font: inherit; padding: 4px 8px; border: 1px solid #d0d7de; border-radius: 6px; background: #fff; }
+        .filters input { flex: 1; min-width: 200px; }
+        .file h3 { margin: 0 0 8px; font-size: 15px; word-break: break-all; }
+        .issue { border-top: 1px solid #d0d7de; padding: 8px 0; }
+        .issue:first-of-type { border-top: none; }
+        .issue-title { font-weight: 600; color: #cf222e; }
+        .issue-meta { margin: 4px 0; color: #57606a; }
+        .badge { display: inline-block; padding: 0 6px; margin-left: 6px; border-radius: 10px; background: #eaeef2; font-size: 12px; }
+        .badge.severity { background: #fff8c5; }
+        pre { margin: 4px 0 0; padding: 8px 0; overflow: auto; background: #f6f8fa; border: 1px solid #d0d7de; border-radius: 6px; font-family: SFMono-Regular, Consolas, "Liberation Mono", Menlo, monospace; font-size: 12px; }
+        .line { display: block; padding: 0 8px; white-space: pre; }
+        .line.highlight { background: #fff8c5; }
+        .line-number { display: inline-block; width: 48px; padding-right: 8px; text-align: right; color: #8c959f; user-select: none; }
+        .line mark { background: #ffd8b5; }
+        .diff-add { background: #dafbe1; }
+        .diff-del { background: #ffebe9; }
+        .diff-hunk { color: #8250df; }
+        .empty { color: #57606a; }
+        summary { cursor: pointer; color: #0969da; margin-top: 4px; }
+    </style>
 </head>
 <body>
-<section class="section">
-    <div class="container">
-        <div id="content"></div>
-    </div>
-</section>
+<header>
+    <h1>golangci-lint</h1>
+    <p id="summary"></p>
+</header>
+<main>
+    <aside>
+        <div class="panel">
+            <h2>Files</h2>
+            <div id="tree"></div>
+        </div>
+        <div class="panel">
+            <h2>Linters</h2>
+            <table class="counts" id="linters"></table>
+        </div>
+        <div class="panel">
+            <h2>Severities</h2>
+            <table class="counts" id="severities"></table>
+        </div>
+    </aside>
+    <section class="content">
+        <div class="panel filters">
+            <input type="search" id="filter-text" placeholder="Filter by text">
+            <select id="filter-linter"><option value="">All linters</option></select>
+            <select id="filter-severity"><option value="">All severities</option></select>
+            <button type="button" id="filter-reset">Reset</button>
+        </div>
+        <div id="issues"></div>
+    </section>
+</main>
 <script>
     const data = {{ . }};
 </script>
-<script type="text/babel">
-  class Highlight extends React.Component {
-    componentDidMount() {
-      hljs.highlightElement(ReactDOM.findDOMNode(this));
-    }
+<script>
+(function () {
+  "use strict";
 
-    render() {
-      return <pre className="go"><code>{this.props.code}</code></pre>;
+  var issues = data.Issues || [];
+  var state = { text: "", linter: "", severity: "", path: "" };
+
+  function el(tag, className, text) {
+    var node = document.createElement(tag);
+    if (className) {
+      node.className = className;
+    }
+    if (text !== undefined) {
+      node.textContent = text;
     }
+    return node;
   }
 
-  class Issue extends React.Component {
-    render() {
-      return (
-        <div className="issue box">
-          <div>
-            <div className="columns">
-              <div className="column is-four-fifths">
-                <h5 className="title is-5 has-text-danger-dark">{this.props.data.Title}</h5>
-              </div>
-              <div className="column is-one-fifth">
-                <h6 className="title is-6">{this.props.data.Linter}</h6>
-              </div>
-            </div>
-            <strong>{this.props.data.Pos}</strong>
-          </div>
-          <div className="highlight">
-            <Highlight code={this.props.data.Code}/>
-          </div>
-        </div>
-      );
+  function matches(issue) {
+    if (state.linter && issue.Linter !== state.linter) {
+      return false;
+    }
+    if (state.severity && issue.Severity !== state.severity) {
+      return false;
+    }
+    if (state.path && issue.File !== state.path && issue.File.indexOf(state.path + "/") !== 0) {
+      return false;
     }
+    if (state.text) {
+      var text = state.text.toLowerCase();
+      return issue.Title.toLowerCase().indexOf(text) >= 0 || issue.Pos.toLowerCase().indexOf(text) >= 0;
+    }
+    return true;
+  }
+
+  function buildTree() {
+    var root = { name: "", path: "", children: {}, count: 0 };
+    issues.forEach(function (issue) {
+      var node = root;
+      root.count++;
+      issue.File.split("/").forEach(function (part) {
+        var path = node.path ? node.path + "/" + part : part;
+        if (!node.children[part]) {
+          node.children[part] = { name: part, path: path, children: {}, count: 0 };
+        }
+        node = node.children[part];
+        node.count++;
+      });
+    });
+    return root;
+  }
+
+  function onlyChild(node) {
+    var names = Object.keys(node.children);
+    return names.length === 1 ? node.children[names[0]] : null;
   }
 
-  class Issues extends React.Component {
-    render() {
-      if (!this.props.data.Issues || this.props.data.Issues.length === 0) {
-        return (
-          <div>
-            <div className="notification">
-              No issues found!
-            </div>
-          </div>
-        );
+  function renderTree(container, node, depth) {
+    Object.keys(node.children).sort().forEach(function (name) {
+      var child = node.children[name];
+      // The directories with a single child are collapsed.
+      var label = child.name;
+      var only = onlyChild(child);
+      while (only && Object.keys(only.children).length > 0) {
+        child = only;
+        label += "/" + child.name;
+        only = onlyChild(child);
       }
+      var item = el("div", "tree-node" + (state.path === child.path ? " selected" : ""), label);
+      item.style.paddingLeft = (4 + depth * 12) + "px";
+      item.title = child.path;
+      item.appendChild(el("span", "count", String(child.count)));
+      item.addEventListener("click", function () {
+        state.path = state.path === child.path ? "" : child.path;
+        render();
+      });
+      container.appendChild(item);
+      renderTree(container, child, depth + 1);
+    });
+  }
 
-      return (
-        <div className="issues">
-          {this.props.data.Issues.map(issue => (<Issue data={issue}/>))}
-        </div>
-      );
+  function renderCounts(table, counts, key) {
+    table.textContent = "";
+    (counts || []).forEach(function (count) {
+      var row = el("tr", state[key] === count.Name ? "selected" : "");
+      row.appendChild(el("td", "", count.Name));
+      row.appendChild(el("td", "count", String(count.Count)));
+      row.addEventListener("click", function () {
+        state[key] = state[key] === count.Name ? "" : count.Name;
+        render();
+      });
+      table.appendChild(row);
+    });
+  }
+
+  function fillSelect(select, counts) {
+    (counts || []).forEach(function (count) {
+      var option = el("option", "", count.Name);
+      option.value = count.Name;
+      select.appendChild(option);
+    });
+  }
+
+  function renderSource(issue) {
+    var pre = el("pre");
+    issue.Source.forEach(function (line) {
+      var row = el("span", "line" + (line.Highlight ? " highlight" : ""));
+      row.appendChild(el("span", "line-number", String(line.Number)));
+      var column = line.Number === issue.Line ? issue.Column : 0;
+      if (column > 0 && column <= line.Text.length) {
+        row.appendChild(document.createTextNode(line.Text.slice(0, column - 1)));
+        row.appendChild(el("mark", "", line.Text.charAt(column - 1)));
+        row.appendChild(document.createTextNode(line.Text.slice(column)));
+      } else {
+        row.appendChild(document.createTextNode(line.Text));
+      }
+      pre.appendChild(row);
+    });
+    return pre;
+  }
+
+  function renderFix(issue) {
+    var details = el("details");
+    details.appendChild(el("summary", "", "Suggested fix"));
+    var pre = el("pre");
+    issue.Fix.split("\n").forEach(function (line) {
+      var className = "line";
+      if (line.indexOf("@@") === 0) {
+        className += " diff-hunk";
+      } else if (line.indexOf("+") === 0 && line.indexOf("+++") !== 0) {
+        className += " diff-add";
+      } else if (line.indexOf("-") === 0 && line.indexOf("---") !== 0) {
+        className += " diff-del";
+      }
+      pre.appendChild(el("span", className, line));
+    });
+    details.appendChild(pre);
+    return details;
+  }
+
+  function renderIssue(issue) {
+    var node = el("div", "issue");
+    node.appendChild(el("div", "issue-title", issue.Title));
+    var meta = el("div", "issue-meta", issue.Pos);
+    meta.appendChild(el("span", "badge", issue.Linter));
+    meta.appendChild(el("span", "badge severity", issue.Severity));
+    node.appendChild(meta);
+    if (issue.Source && issue.Source.length > 0) {
+      node.appendChild(renderSource(issue));
+    }
+    if (issue.Fix) {
+      node.appendChild(renderFix(issue));
     }
+    return node;
   }
 
-  ReactDOM.render(
-    <div className="content">
-      <div className="columns is-centered">
-        <div className="column is-three-quarters">
-          <Issues data={data}/>
-        </div>
-      </div>
-    </div>,
-    document.getElementById("content")
-  );
+  function renderIssues(container) {
+    container.textContent = "";
+    var files = [];
+    var byFile = {};
+    var shown = 0;
+    issues.filter(matches).forEach(function (issue) {
+      if (!byFile[issue.File]) {
+        byFile[issue.File] = [];
+        files.push(issue.File);
+      }
+      byFile[issue.File].push(issue);
+      shown++;
+    });
+    if (shown === 0) {
+      var empty = el("div", "panel empty", issues.length === 0 ? "No issues found!" : "No issues match the filters.");
+      container.appendChild(empty);
+    }
+    files.forEach(function (file) {
+      var panel = el("div", "panel file");
+      var title = el("h3", "", file);
+      title.appendChild(el("span", "badge", String(byFile[file].length)));
+      panel.appendChild(title);
+      byFile[file].forEach(function (issue) {
+        panel.appendChild(renderIssue(issue));
+      });
+      container.appendChild(panel);
+    });
+    return shown;
+  }
+
+  var tree = buildTree();
+  var fileCount = Object.keys(issues.reduce(function (files, issue) {
+    files[issue.File] = true;
+    return files;
+  }, {})).length;
+
+  function render() {
+    var treeContainer = document.getElementById("tree");
+    treeContainer.textContent = "";
+    renderTree(treeContainer, tree, 0);
+    renderCounts(document.getElementById("linters"), data.Linters, "linter");
+    renderCounts(document.getElementById("severities"), data.Severities, "severity");
+    document.getElementById("filter-linter").value = state.linter;
+    document.getElementById("filter-severity").value = state.severity;
+    var shown = renderIssues(document.getElementById("issues"));
+    document.getElementById("summary").textContent = issues.length + " issues in " + fileCount + " files" +
+      (shown !== issues.length ? " (" + shown + " shown)" : "");
+  }
+
+  fillSelect(document.getElementById("filter-linter"), data.Linters);
+  fillSelect(document.getElementById("filter-severity"), data.Severities);
+
+  document.getElementById("filter-text").addEventListener("input", function (event) {
+    state.text = event.target.value;
+    render();
+  });
+  document.getElementById("filter-linter").addEventListener("change", function (event) {
+    state.linter = event.target.value;
+    render();
+  });
+  document.getElementById("filter-severity").addEventListener("change", function (event) {
+    state.severity = event.target.value;
+    render();
+  });
+  document.getElementById("filter-reset").addEventListener("click", function () {
+    state = { text: "", linter: "", severity: "", path: "" };
+    document.getElementById("filter-text").value = "";
+    render();
+  });
+
+  render();
+})();
 </script>
 </body>
 </html>`
 
+// htmlContextLines is the number of lines of source code shown around the lines of an issue.
+const htmlContextLines = 3
+
+// htmlNoSeverity is the severity shown for the issues without severity.
+const htmlNoSeverity = "none"
+
+type htmlReport struct {
+	Issues     []htmlIssue
+	Linters    []htmlCount
+	Severities []htmlCount
+}
+
 type htmlIssue struct {
-	Title  string
-	Pos    string
-	Linter string
-	Code   string
+	Title    string
+	Pos      string
+	File     string
+	Line     int
+	Column   int
+	Linter   string
+	Severity string
+	// The lines of the issue (highlighted) with the lines around them.
+	Source []htmlSourceLine
+	// The unified diff of the fix, if the issue has a replacement.
+	Fix string
+}
+
+type htmlSourceLine struct {
+	Number    int
+	Text      string
+	Highlight bool
+}
+
+type htmlCount struct {
+	Name  string
+	Count int
 }
 
 type HTML struct {
@@ -131,26 +367,106 @@ func NewHTML(w io.Writer) *HTML {
 }
 
 func (p HTML) Print(issues []result.Issue) error {
-	var htmlIssues []htmlIssue
+	t, err := template.New("golangci-lint").Parse(templateContent)
+	if err != nil {
+		return err
+	}
+
+	return t.Execute(p.w, newHTMLReport(issues))
+}
+
+func newHTMLReport(issues []result.Issue) *htmlReport {
+	report := &htmlReport{Issues: []htmlIssue{}}
+
+	sources := htmlSources{sourceFiles: sourceFiles{}}
+	linters := map[string]int{}
+	severities := map[string]int{}
 
 	for i := range issues {
-		pos := fmt.Sprintf("%s:%d", issues[i].FilePath(), issues[i].Line())
-		if issues[i].Pos.Column != 0 {
-			pos += fmt.Sprintf(":%d", issues[i].Pos.Column)
+		issue := &issues[i]
+
+		pos := fmt.Sprintf("%s:%d", issue.FilePath(), issue.Line())
+		if issue.Pos.Column != 0 {
+			pos += fmt.Sprintf(":%d", issue.Pos.Column)
+		}
+
+		severity := issue.Severity
+		if severity == "" {
+			severity = htmlNoSeverity
 		}
 
-		htmlIssues = append(htmlIssues, htmlIssue{
-			Title:  strings.TrimSpace(issues[i].Text),
-			Pos:    pos,
-			Linter: issues[i].FromLinter,
-			Code:   strings.Join(issues[i].SourceLines, "\n"),
+		report.Issues = append(report.Issues, htmlIssue{
+			Title:    strings.TrimSpace(issue.Text),
+			Pos:      pos,
+			File:     issue.FilePath(),
+			Line:     issue.Line(),
+			Column:   issue.Column(),
+			Linter:   issue.FromLinter,
+			Severity: severity,
+			Source:   sources.context(issue),
+			Fix:      sources.fix(issue),
 		})
+
+		linters[issue.FromLinter]++
+		severities[severity]++
 	}
 
-	t, err := template.New("golangci-lint").Parse(templateContent)
-	if err != nil {
-		return err
+	report.Linters = sortedHTMLCounts(linters)
+	report.Severities = sortedHTMLCounts(severities)
+
+	return report
+}
+
+// sortedHTMLCounts sorts the counts by decreasing count, then by name.
+func sortedHTMLCounts(counts map[string]int) []htmlCount {
+	sorted := make([]htmlCount, 0, len(counts))
+	for name, count := range counts {
+		sorted = append(sorted, htmlCount{Name: name, Count: count})
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Count != sorted[j].Count {
+			return sorted[i].Count > sorted[j].Count
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	return sorted
+}
+
+// htmlSources computes the source context of the issues:
+// the source lines of the issue are used when its file can't be read.
+type htmlSources struct {
+	sourceFiles
+}
+
+// context returns the lines of the issue, highlighted, with the lines around them.
+func (s htmlSources) context(issue *result.Issue) []htmlSourceLine {
+	rng := issue.GetLineRange()
+
+	var sourceLines []htmlSourceLine
+
+	data := s.data(issue.FilePath())
+
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	if data == nil || rng.From < 1 || rng.To > len(lines) {
+		for i, line := range issue.SourceLines {
+			sourceLines = append(sourceLines, htmlSourceLine{Number: rng.From + i, Text: line, Highlight: true})
+		}
+
+		return sourceLines
+	}
+
+	from := max(1, rng.From-htmlContextLines)
+	to := min(len(lines), rng.To+htmlContextLines)
+
+	for number := from; number <= to; number++ {
+		sourceLines = append(sourceLines, htmlSourceLine{
+			Number:    number,
+			Text:      strings.TrimSuffix(lines[number-1], "\r"),
+			Highlight: number >= rng.From && number <= rng.To,
+		})
 	}
 
-	return t.Execute(p.w, struct{ Issues []htmlIssue }{Issues: htmlIssues})
+	return sourceLines
 }
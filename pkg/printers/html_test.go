@@ -2,7 +2,11 @@ package printers
 
 import (
 	"bytes"
+	"encoding/json"
 	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -11,112 +15,6 @@ import (
 	"github.com/snowmerak/golangci-lint/pkg/result"
 )
 
-const expectedHTML = `<!doctype html>
-<html lang="en">
-<head>
-    <meta charset="utf-8">
-    <title>golangci-lint</title>
-    <link rel="shortcut icon" type="image/png" href="https://golangci-lint.run/favicon-32x32.png">
-    <link rel="stylesheet" href="https://cdnjs.cloudflare.com/ajax/libs/bulma/0.9.2/css/bulma.min.css"
-          integrity="sha512-byErQdWdTqREz6DLAA9pCnLbdoGGhXfU6gm1c8bkf7F51JVmUBlayGe2A31VpXWQP+eiJ3ilTAZHCR3vmMyybA=="
-          crossorigin="anonymous" referrerpolicy="no-referrer"/>
-    <link rel="stylesheet" href="https://cdnjs.cloudflare.com/ajax/libs/highlight.js/10.7.2/styles/default.min.css"
-          integrity="sha512-kZqGbhf9JTB4bVJ0G8HCkqmaPcRgo88F0dneK30yku5Y/dep7CZfCnNml2Je/sY4lBoqoksXz4PtVXS4GHSUzQ=="
-          crossorigin="anonymous" referrerpolicy="no-referrer"/>
-    <script type="text/javascript" src="https://cdnjs.cloudflare.com/ajax/libs/highlight.js/10.7.2/highlight.min.js"
-            integrity="sha512-s+tOYYcC3Jybgr9mVsdAxsRYlGNq4mlAurOrfNuGMQ/SCofNPu92tjE7YRZCsdEtWL1yGkqk15fU/ark206YTg=="
-            crossorigin="anonymous" referrerpolicy="no-referrer"></script>
-    <script type="text/javascript" src="https://cdnjs.cloudflare.com/ajax/libs/highlight.js/10.7.2/languages/go.min.js"
-            integrity="sha512-+UYV2NyyynWEQcZ4sMTKmeppyV331gqvMOGZ61/dqc89Tn1H40lF05ACd03RSD9EWwGutNwKj256mIR8waEJBQ=="
-            crossorigin="anonymous" referrerpolicy="no-referrer"></script>
-    <script type="text/javascript" src="https://cdnjs.cloudflare.com/ajax/libs/react/17.0.2/umd/react.production.min.js"
-            integrity="sha512-qlzIeUtTg7eBpmEaS12NZgxz52YYZVF5myj89mjJEesBd/oE9UPsYOX2QAXzvOAZYEvQohKdcY8zKE02ifXDmA=="
-            crossorigin="anonymous" referrerpolicy="no-referrer"></script>
-    <script type="text/javascript"
-            src="https://cdnjs.cloudflare.com/ajax/libs/react-dom/17.0.2/umd/react-dom.production.min.js"
-            integrity="sha512-9jGNr5Piwe8nzLLYTk8QrEMPfjGU0px80GYzKZUxi7lmCfrBjtyCc1V5kkS5vxVwwIB7Qpzc7UxLiQxfAN30dw=="
-            crossorigin="anonymous" referrerpolicy="no-referrer"></script>
-    <script type="text/javascript" src="https://cdnjs.cloudflare.com/ajax/libs/babel-standalone/6.26.0/babel.min.js"
-            integrity="sha512-kp7YHLxuJDJcOzStgd6vtpxr4ZU9kjn77e6dBsivSz+pUuAuMlE2UTdKB7jjsWT84qbS8kdCWHPETnP/ctrFsA=="
-            crossorigin="anonymous" referrerpolicy="no-referrer"></script>
-</head>
-<body>
-<section class="section">
-    <div class="container">
-        <div id="content"></div>
-    </div>
-</section>
-<script>
-    const data = {"Issues":[{"Title":"some issue","Pos":"path/to/filea.go:10:4","Linter":"linter-a","Code":""},{"Title":"another issue","Pos":"path/to/fileb.go:300:9","Linter":"linter-b","Code":"func foo() {\n\tfmt.Println(\"bar\")\n}"}]};
-</script>
-<script type="text/babel">
-  class Highlight extends React.Component {
-    componentDidMount() {
-      hljs.highlightElement(ReactDOM.findDOMNode(this));
-    }
-
-    render() {
-      return <pre className="go"><code>{this.props.code}</code></pre>;
-    }
-  }
-
-  class Issue extends React.Component {
-    render() {
-      return (
-        <div className="issue box">
-          <div>
-            <div className="columns">
-              <div className="column is-four-fifths">
-                <h5 className="title is-5 has-text-danger-dark">{this.props.data.Title}</h5>
-              </div>
-              <div className="column is-one-fifth">
-                <h6 className="title is-6">{this.props.data.Linter}</h6>
-              </div>
-            </div>
-            <strong>{this.props.data.Pos}</strong>
-          </div>
-          <div className="highlight">
-            <Highlight code={this.props.data.Code}/>
-          </div>
-        </div>
-      );
-    }
-  }
-
-  class Issues extends React.Component {
-    render() {
-      if (!this.props.data.Issues || this.props.data.Issues.length === 0) {
-        return (
-          <div>
-            <div className="notification">
-              No issues found!
-            </div>
-          </div>
-        );
-      }
-
-      return (
-        <div className="issues">
-          {this.props.data.Issues.map(issue => (<Issue data={issue}/>))}
-        </div>
-      );
-    }
-  }
-
-  ReactDOM.render(
-    <div className="content">
-      <div className="columns is-centered">
-        <div className="column is-three-quarters">
-          <Issues data={data}/>
-        </div>
-      </div>
-    </div>,
-    document.getElementById("content")
-  );
-</script>
-</body>
-</html>`
-
 func TestHTML_Print(t *testing.T) {
 	issues := []result.Issue{
 		{
@@ -132,13 +30,13 @@ func TestHTML_Print(t *testing.T) {
 		},
 		{
 			FromLinter: "linter-b",
-			Severity:   "error",
 			Text:       "another issue",
 			SourceLines: []string{
 				"func foo() {",
 				"\tfmt.Println(\"bar\")",
 				"}",
 			},
+			LineRange: &result.Range{From: 300, To: 302},
 			Pos: token.Position{
 				Filename: "path/to/fileb.go",
 				Offset:   5,
@@ -146,6 +44,15 @@ func TestHTML_Print(t *testing.T) {
 				Column:   9,
 			},
 		},
+		{
+			FromLinter: "linter-a",
+			Severity:   "warning",
+			Text:       "another issue",
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Line:     310,
+			},
+		},
 	}
 
 	buf := new(bytes.Buffer)
@@ -154,5 +61,110 @@ func TestHTML_Print(t *testing.T) {
 	err := printer.Print(issues)
 	require.NoError(t, err)
 
-	assert.Equal(t, expectedHTML, buf.String())
+	// The page is self-contained.
+	assert.NotContains(t, buf.String(), "http://")
+	assert.NotContains(t, buf.String(), "https://")
+
+	expected := &htmlReport{
+		Issues: []htmlIssue{
+			{
+				Title:    "some issue",
+				Pos:      "path/to/filea.go:10:4",
+				File:     "path/to/filea.go",
+				Line:     10,
+				Column:   4,
+				Linter:   "linter-a",
+				Severity: "warning",
+			},
+			{
+				Title:    "another issue",
+				Pos:      "path/to/fileb.go:300:9",
+				File:     "path/to/fileb.go",
+				Line:     300,
+				Column:   9,
+				Linter:   "linter-b",
+				Severity: "none",
+				Source: []htmlSourceLine{
+					{Number: 300, Text: "func foo() {", Highlight: true},
+					{Number: 301, Text: "\tfmt.Println(\"bar\")", Highlight: true},
+					{Number: 302, Text: "}", Highlight: true},
+				},
+			},
+			{
+				Title:    "another issue",
+				Pos:      "path/to/fileb.go:310",
+				File:     "path/to/fileb.go",
+				Line:     310,
+				Linter:   "linter-a",
+				Severity: "warning",
+			},
+		},
+		Linters: []htmlCount{
+			{Name: "linter-a", Count: 2},
+			{Name: "linter-b", Count: 1},
+		},
+		Severities: []htmlCount{
+			{Name: "warning", Count: 2},
+			{Name: "none", Count: 1},
+		},
+	}
+
+	assert.Equal(t, expected, extractHTMLReport(t, buf.String()))
+}
+
+func TestHTML_Print_sourceContext(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "main.go")
+
+	data := "package main\n\nimport \"fmt\"\n\nfunc main() {\n  fmt.Println(\"a\")\n}\n"
+
+	err := os.WriteFile(filePath, []byte(data), 0o600)
+	require.NoError(t, err)
+
+	issues := []result.Issue{
+		{
+			FromLinter:  "gofmt",
+			Text:        "File is not `gofmt`-ed",
+			SourceLines: []string{"  fmt.Println(\"a\")"},
+			Pos:         token.Position{Filename: filePath, Line: 6},
+			Replacement: &result.Replacement{NewLines: []string{"\tfmt.Println(\"a\")"}},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	err = NewHTML(buf).Print(issues)
+	require.NoError(t, err)
+
+	report := extractHTMLReport(t, buf.String())
+	require.Len(t, report.Issues, 1)
+
+	expectedSource := []htmlSourceLine{
+		{Number: 3, Text: "import \"fmt\""},
+		{Number: 4, Text: ""},
+		{Number: 5, Text: "func main() {"},
+		{Number: 6, Text: "  fmt.Println(\"a\")", Highlight: true},
+		{Number: 7, Text: "}"},
+	}
+
+	assert.Equal(t, expectedSource, report.Issues[0].Source)
+	assert.Contains(t, report.Issues[0].Fix, "-  fmt.Println(\"a\")\n+\tfmt.Println(\"a\")\n")
+}
+
+// extractHTMLReport returns the data of the page.
+func extractHTMLReport(t *testing.T, page string) *htmlReport {
+	t.Helper()
+
+	const prefix = "const data = "
+
+	start := strings.Index(page, prefix)
+	require.GreaterOrEqual(t, start, 0)
+
+	line, _, _ := strings.Cut(page[start+len(prefix):], "\n")
+
+	var report htmlReport
+
+	err := json.Unmarshal([]byte(strings.TrimSuffix(line, ";")), &report)
+	require.NoError(t, err)
+
+	return &report
 }
@@ -58,7 +58,7 @@ func (p JunitXML) Print(issues []result.Issue) error {
 		testSuite.Failures++
 
 		tc := testCaseXML{
-			Name:      i.FromLinter,
+			Name:      i.CheckName(),
 			ClassName: i.Pos.String(),
 			Failure: failureXML{
 				Type:    i.Severity,
@@ -0,0 +1,358 @@
+package printers
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/report"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+// The comments of GitHub are limited to 65536 characters.
+const defaultMarkdownMaxSize = 65536
+
+// The number of files of the "Top files" table.
+const markdownTopFiles = 10
+
+// The truncation notice is always kept: it must fit inside this size.
+const markdownTruncationReserve = 256
+
+// Markdown prints a summary of the issues (ex: for pull request comments or CI job summaries).
+type Markdown struct {
+	rd  *report.Data
+	cfg *config.MarkdownOutput
+	w   io.Writer
+}
+
+func NewMarkdown(rd *report.Data, cfg *config.MarkdownOutput, w io.Writer) *Markdown {
+	return &Markdown{
+		rd:  rd,
+		cfg: cfg,
+		w:   w,
+	}
+}
+
+// markdownFile is the issues of a file.
+type markdownFile struct {
+	path   string
+	issues []*result.Issue
+}
+
+func (p Markdown) Print(issues []result.Issue) error {
+	maxSize := p.cfg.MaxSize
+	if maxSize == 0 {
+		maxSize = defaultMarkdownMaxSize
+	}
+
+	files := groupMarkdownFiles(issues)
+
+	// The truncation notice must always fit.
+	budget := max(maxSize-markdownTruncationReserve, 0)
+
+	var b strings.Builder
+
+	b.WriteString("## golangci-lint\n\n")
+
+	var summary, warnings, linters strings.Builder
+
+	if len(issues) == 0 {
+		b.WriteString("No issues found.\n")
+	} else {
+		fmt.Fprintf(&b, "**%s** found in %s.\n", plural(len(issues), "issue"), plural(len(files), "file"))
+
+		p.writeSummary(&summary, issues, files)
+	}
+
+	p.writeWarnings(&warnings)
+	p.writeLinters(&linters)
+
+	// The tables and the list of warnings are truncated to whole lines,
+	// the collapsible section of the linters can't be split.
+	truncated := !appendMarkdownSection(&b, summary.String(), budget, true)
+	truncated = !appendMarkdownSection(&b, warnings.String(), budget, true) || truncated
+	truncated = !appendMarkdownSection(&b, linters.String(), budget, false) || truncated
+
+	// The per-file sections are truncated to fit inside the maximal size.
+	const sectionsHeader = "\n### Issues\n"
+
+	limit := max(budget-b.Len()-len(sectionsHeader), 0)
+
+	var sections strings.Builder
+
+	var printed int
+
+	for _, file := range files {
+		n := p.writeFile(&sections, file, limit)
+		printed += n
+
+		if n < len(file.issues) {
+			break
+		}
+	}
+
+	if sections.Len() > 0 {
+		b.WriteString(sectionsHeader)
+		b.WriteString(sections.String())
+	}
+
+	switch {
+	case printed < len(issues):
+		fmt.Fprintf(&b, "\n> [!NOTE]\n> The output is truncated: %s not shown.\n", plural(len(issues)-printed, "issue"))
+	case truncated:
+		b.WriteString("\n> [!NOTE]\n> The output is truncated.\n")
+	}
+
+	_, err := io.WriteString(p.w, b.String())
+
+	return err
+}
+
+// appendMarkdownSection appends a section if it fits inside the limit,
+// else the first lines which fit if the section can be split.
+// It reports whether the whole section was appended.
+func appendMarkdownSection(b *strings.Builder, section string, limit int, split bool) bool {
+	if b.Len()+len(section) <= limit {
+		b.WriteString(section)
+		return true
+	}
+
+	if !split {
+		return false
+	}
+
+	for _, line := range strings.SplitAfter(section, "\n") {
+		if b.Len()+len(line) > limit {
+			break
+		}
+
+		b.WriteString(line)
+	}
+
+	return false
+}
+
+func (p Markdown) writeSummary(b *strings.Builder, issues []result.Issue, files []*markdownFile) {
+	linters := map[string]int{}
+	severities := map[string]int{}
+
+	for i := range issues {
+		linters[issues[i].FromLinter]++
+
+		severity := issues[i].Severity
+		if severity == "" {
+			severity = htmlNoSeverity
+		}
+
+		severities[severity]++
+	}
+
+	b.WriteString("\n### Summary\n\n| Linter | Issues |\n| --- | ---: |\n")
+
+	for _, count := range sortedHTMLCounts(linters) {
+		fmt.Fprintf(b, "| %s | %d |\n", markdownCode(count.Name), count.Count)
+	}
+
+	b.WriteString("\n| Severity | Issues |\n| --- | ---: |\n")
+
+	for _, count := range sortedHTMLCounts(severities) {
+		fmt.Fprintf(b, "| %s | %d |\n", markdownEscape(count.Name), count.Count)
+	}
+
+	topFiles := make([]*markdownFile, len(files))
+	copy(topFiles, files)
+
+	sort.SliceStable(topFiles, func(i, j int) bool {
+		return len(topFiles[i].issues) > len(topFiles[j].issues)
+	})
+
+	b.WriteString("\n### Top files\n\n| File | Issues |\n| --- | ---: |\n")
+
+	for _, file := range topFiles[:min(len(topFiles), markdownTopFiles)] {
+		fmt.Fprintf(b, "| %s | %d |\n", p.link(markdownCode(file.path), file.path, ""), len(file.issues))
+	}
+}
+
+func (p Markdown) writeWarnings(b *strings.Builder) {
+	if len(p.rd.Warnings) == 0 {
+		return
+	}
+
+	b.WriteString("\n### Warnings\n\n")
+
+	for _, warning := range p.rd.Warnings {
+		b.WriteString("- ")
+
+		if warning.Tag != "" {
+			fmt.Fprintf(b, "%s ", markdownCode(warning.Tag))
+		}
+
+		b.WriteString(markdownEscape(warning.Text) + "\n")
+	}
+}
+
+func (p Markdown) writeLinters(b *strings.Builder) {
+	var enabled []string
+
+	for _, ld := range p.rd.Linters {
+		if ld.Enabled {
+			enabled = append(enabled, markdownCode(ld.Name))
+		}
+	}
+
+	if len(enabled) == 0 {
+		return
+	}
+
+	fmt.Fprintf(b, "\n<details>\n<summary>%s</summary>\n\n%s\n\n</details>\n",
+		plural(len(enabled), "enabled linter"), strings.Join(enabled, ", "))
+}
+
+// writeFile writes the collapsible section of a file with as many issues as possible before the size limit of the sections.
+// It returns the number of written issues.
+func (p Markdown) writeFile(b *strings.Builder, file *markdownFile, limit int) int {
+	// The Markdown isn't rendered inside the HTML tags.
+	header := fmt.Sprintf("\n<details>\n<summary><code>%s</code> (%s)</summary>\n\n",
+		html.EscapeString(file.path), plural(len(file.issues), "issue"))
+
+	const footer = "\n</details>\n"
+
+	var entries strings.Builder
+
+	written := 0
+
+	for _, issue := range file.issues {
+		entry := p.issueEntry(issue)
+
+		if b.Len()+len(header)+entries.Len()+len(entry)+len(footer) > limit {
+			break
+		}
+
+		entries.WriteString(entry)
+		written++
+	}
+
+	if written > 0 {
+		b.WriteString(header)
+		b.WriteString(entries.String())
+		b.WriteString(footer)
+	}
+
+	return written
+}
+
+func (p Markdown) issueEntry(issue *result.Issue) string {
+	var entry strings.Builder
+
+	pos := fmt.Sprintf("%s:%d", issue.FilePath(), issue.Line())
+	if issue.Column() > 0 {
+		pos += fmt.Sprintf(":%d", issue.Column())
+	}
+
+	anchor := fmt.Sprintf("#L%d", issue.Line())
+	if rng := issue.GetLineRange(); rng.To > rng.From {
+		anchor = fmt.Sprintf("#L%d-L%d", rng.From, rng.To)
+	}
+
+	fmt.Fprintf(&entry, "- %s %s: %s", p.link(markdownCode(pos), issue.FilePath(), anchor),
+		markdownCode(issue.CheckName()), markdownEscape(issue.Text))
+
+	if issue.Severity != "" {
+		fmt.Fprintf(&entry, " (%s)", markdownEscape(issue.Severity))
+	}
+
+	entry.WriteString("\n")
+
+	if len(issue.SourceLines) > 0 {
+		source := strings.Join(issue.SourceLines, "\n")
+
+		// The fence must be longer than the backtick sequences of the code.
+		fence := "```"
+		for strings.Contains(source, fence) {
+			fence += "`"
+		}
+
+		fmt.Fprintf(&entry, "\n  %sgo\n", fence)
+
+		for _, line := range issue.SourceLines {
+			entry.WriteString("  " + line + "\n")
+		}
+
+		fmt.Fprintf(&entry, "  %s\n", fence)
+	}
+
+	return entry.String()
+}
+
+// link returns the text as a link to the file, if a base URL is configured.
+func (p Markdown) link(text, filePath, anchor string) string {
+	if p.cfg.BaseURL == "" || filePath == "" {
+		return text
+	}
+
+	return fmt.Sprintf("[%s](%s/%s%s)", text, strings.TrimSuffix(p.cfg.BaseURL, "/"),
+		strings.TrimPrefix(filepath.ToSlash(filePath), "/"), anchor)
+}
+
+// groupMarkdownFiles groups the issues by file, in the order of the first issue of each file,
+// and sorts the issues of each file by position.
+func groupMarkdownFiles(issues []result.Issue) []*markdownFile {
+	var files []*markdownFile
+
+	index := map[string]*markdownFile{}
+
+	for i := range issues {
+		issue := &issues[i]
+
+		file, ok := index[issue.FilePath()]
+		if !ok {
+			file = &markdownFile{path: issue.FilePath()}
+			index[issue.FilePath()] = file
+			files = append(files, file)
+		}
+
+		file.issues = append(file.issues, issue)
+	}
+
+	// The issues of the linters are not interleaved if the results are not sorted.
+	for _, file := range files {
+		sort.SliceStable(file.issues, func(i, j int) bool {
+			a, b := file.issues[i], file.issues[j]
+			if a.Line() != b.Line() {
+				return a.Line() < b.Line()
+			}
+
+			return a.Column() < b.Column()
+		})
+	}
+
+	return files
+}
+
+// markdownEscape escapes the characters interpreted inside the tables and as HTML.
+func markdownEscape(s string) string {
+	return strings.NewReplacer("|", `\|`, "<", "&lt;", ">", "&gt;", "\n", " ").Replace(s)
+}
+
+// markdownCode returns the text as inline code.
+func markdownCode(s string) string {
+	s = strings.NewReplacer("|", `\|`, "\n", " ").Replace(s)
+
+	if strings.Contains(s, "`") {
+		return "`` " + s + " ``"
+	}
+
+	return "`" + s + "`"
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+
+	return fmt.Sprintf("%d %ss", n, noun)
+}
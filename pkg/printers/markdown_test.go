@@ -0,0 +1,251 @@
+package printers
+
+import (
+	"bytes"
+	"fmt"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/report"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+func TestMarkdown_Print(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Severity:   "warning",
+			Text:       "some issue",
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Line:     10,
+				Column:   4,
+			},
+		},
+		{
+			FromLinter: "linter-b",
+			RuleID:     "B001",
+			Text:       "another <issue> | `x`",
+			SourceLines: []string{
+				"func foo() {",
+				"\tfmt.Println(\"bar\")",
+				"}",
+			},
+			LineRange: &result.Range{From: 300, To: 302},
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Line:     300,
+				Column:   9,
+			},
+		},
+		{
+			FromLinter: "linter-a",
+			Severity:   "warning",
+			Text:       "issue c",
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Line:     310,
+			},
+		},
+	}
+
+	data := &report.Data{
+		Warnings: []report.Warning{{Tag: "runner", Text: "some warning"}},
+	}
+	data.AddLinter("linter-a", true, false, "", "", nil)
+	data.AddLinter("linter-b", true, false, "", "", nil)
+	data.AddLinter("linter-c", false, false, "", "", nil)
+
+	buf := new(bytes.Buffer)
+
+	err := NewMarkdown(data, &config.MarkdownOutput{BaseURL: "https://example.com/blob/main/"}, buf).Print(issues)
+	require.NoError(t, err)
+
+	expected := "## golangci-lint\n" +
+		"\n" +
+		"**3 issues** found in 2 files.\n" +
+		"\n" +
+		"### Summary\n" +
+		"\n" +
+		"| Linter | Issues |\n" +
+		"| --- | ---: |\n" +
+		"| `linter-a` | 2 |\n" +
+		"| `linter-b` | 1 |\n" +
+		"\n" +
+		"| Severity | Issues |\n" +
+		"| --- | ---: |\n" +
+		"| warning | 2 |\n" +
+		"| none | 1 |\n" +
+		"\n" +
+		"### Top files\n" +
+		"\n" +
+		"| File | Issues |\n" +
+		"| --- | ---: |\n" +
+		"| [`path/to/fileb.go`](https://example.com/blob/main/path/to/fileb.go) | 2 |\n" +
+		"| [`path/to/filea.go`](https://example.com/blob/main/path/to/filea.go) | 1 |\n" +
+		"\n" +
+		"### Warnings\n" +
+		"\n" +
+		"- `runner` some warning\n" +
+		"\n" +
+		"<details>\n" +
+		"<summary>2 enabled linters</summary>\n" +
+		"\n" +
+		"`linter-a`, `linter-b`\n" +
+		"\n" +
+		"</details>\n" +
+		"\n" +
+		"### Issues\n" +
+		"\n" +
+		"<details>\n" +
+		"<summary><code>path/to/filea.go</code> (1 issue)</summary>\n" +
+		"\n" +
+		"- [`path/to/filea.go:10:4`](https://example.com/blob/main/path/to/filea.go#L10) `linter-a`: some issue (warning)\n" +
+		"\n" +
+		"</details>\n" +
+		"\n" +
+		"<details>\n" +
+		"<summary><code>path/to/fileb.go</code> (2 issues)</summary>\n" +
+		"\n" +
+		"- [`path/to/fileb.go:300:9`](https://example.com/blob/main/path/to/fileb.go#L300-L302) `linter-b/B001`: another &lt;issue&gt; \\| `x`\n" +
+		"\n" +
+		"  ```go\n" +
+		"  func foo() {\n" +
+		"  \tfmt.Println(\"bar\")\n" +
+		"  }\n" +
+		"  ```\n" +
+		"- [`path/to/fileb.go:310`](https://example.com/blob/main/path/to/fileb.go#L310) `linter-a`: issue c (warning)\n" +
+		"\n" +
+		"</details>\n"
+
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestMarkdown_Print_noIssues(t *testing.T) {
+	buf := new(bytes.Buffer)
+
+	err := NewMarkdown(&report.Data{}, &config.MarkdownOutput{}, buf).Print(nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "## golangci-lint\n\nNo issues found.\n", buf.String())
+}
+
+func TestMarkdown_Print_sortedIssues(t *testing.T) {
+	newIssue := func(linter string, line, column int) result.Issue {
+		return result.Issue{
+			FromLinter: linter,
+			Text:       "issue",
+			Pos:        token.Position{Filename: "main.go", Line: line, Column: column},
+		}
+	}
+
+	// The issues are grouped by linter.
+	issues := []result.Issue{
+		newIssue("linter-a", 5, 7),
+		newIssue("linter-a", 8, 1),
+		newIssue("linter-b", 2, 1),
+		newIssue("linter-b", 5, 2),
+	}
+
+	buf := new(bytes.Buffer)
+
+	err := NewMarkdown(&report.Data{}, &config.MarkdownOutput{}, buf).Print(issues)
+	require.NoError(t, err)
+
+	expected := "### Issues\n" +
+		"\n" +
+		"<details>\n" +
+		"<summary><code>main.go</code> (4 issues)</summary>\n" +
+		"\n" +
+		"- `main.go:2:1` `linter-b`: issue\n" +
+		"- `main.go:5:2` `linter-b`: issue\n" +
+		"- `main.go:5:7` `linter-a`: issue\n" +
+		"- `main.go:8:1` `linter-a`: issue\n" +
+		"\n" +
+		"</details>\n"
+
+	assert.Contains(t, buf.String(), expected)
+}
+
+func TestMarkdown_Print_truncated(t *testing.T) {
+	var issues []result.Issue
+
+	for i := range 100 {
+		issues = append(issues, result.Issue{
+			FromLinter: "linter-a",
+			Text:       "some issue",
+			Pos: token.Position{
+				Filename: fmt.Sprintf("path/to/file%d.go", i%5),
+				Line:     i + 1,
+			},
+		})
+	}
+
+	const maxSize = 3000
+
+	buf := new(bytes.Buffer)
+
+	err := NewMarkdown(&report.Data{}, &config.MarkdownOutput{MaxSize: maxSize}, buf).Print(issues)
+	require.NoError(t, err)
+
+	output := buf.String()
+
+	assert.LessOrEqual(t, len(output), maxSize)
+	assert.Contains(t, output, "**100 issues** found in 5 files.")
+	assert.Contains(t, output, "### Issues")
+	assert.Regexp(t, `The output is truncated: \d+ issues not shown.\n$`, output)
+
+	// The sections are closed.
+	assert.Equal(t, bytes.Count(buf.Bytes(), []byte("<details>")), bytes.Count(buf.Bytes(), []byte("</details>")))
+}
+
+func TestMarkdown_Print_truncatedReport(t *testing.T) {
+	data := &report.Data{}
+
+	for i := range 100 {
+		data.Warnings = append(data.Warnings, report.Warning{Tag: "runner", Text: fmt.Sprintf("some warning %d", i)})
+		data.Linters = append(data.Linters, report.LinterData{Name: fmt.Sprintf("linter-%d", i), Enabled: true})
+	}
+
+	issues := []result.Issue{{
+		FromLinter: "linter-a",
+		Text:       "some issue",
+		Pos:        token.Position{Filename: "path/to/file.go", Line: 1},
+	}}
+
+	const maxSize = 1500
+
+	buf := new(bytes.Buffer)
+
+	err := NewMarkdown(data, &config.MarkdownOutput{MaxSize: maxSize}, buf).Print(issues)
+	require.NoError(t, err)
+
+	output := buf.String()
+
+	assert.LessOrEqual(t, len(output), maxSize)
+	assert.Contains(t, output, "### Warnings")
+	assert.NotContains(t, output, "some warning 99")
+	assert.NotContains(t, output, "enabled linters")
+	assert.Regexp(t, `The output is truncated: 1 issue not shown.\n$`, output)
+}
+
+func TestMarkdown_Print_tinyMaxSize(t *testing.T) {
+	issues := []result.Issue{{
+		FromLinter: "linter-a",
+		Text:       "some issue",
+		Pos:        token.Position{Filename: "path/to/file.go", Line: 1},
+	}}
+
+	buf := new(bytes.Buffer)
+
+	err := NewMarkdown(&report.Data{}, &config.MarkdownOutput{MaxSize: 10}, buf).Print(issues)
+	require.NoError(t, err)
+
+	expected := "## golangci-lint\n\n**1 issue** found in 1 file.\n\n> [!NOTE]\n> The output is truncated: 1 issue not shown.\n"
+
+	assert.Equal(t, expected, buf.String())
+}
@@ -110,6 +110,11 @@ func (c *Printer) createWriter(path string) (io.Writer, bool, error) {
 }
 
 func (c *Printer) createPrinter(format string, w io.Writer) (issuePrinter, error) {
+	outputFormat := config.OutputFormat{Format: format}
+	if path, ok := outputFormat.TemplatePath(); ok {
+		return NewTemplate(c.reportData, path, w)
+	}
+
 	var p issuePrinter
 
 	switch format {
@@ -126,7 +131,7 @@ func (c *Printer) createPrinter(format string, w io.Writer) (issuePrinter, error
 	case config.OutFormatCheckstyle:
 		p = NewCheckstyle(w)
 	case config.OutFormatCodeClimate:
-		p = NewCodeClimate(w)
+		p = NewCodeClimate(c.reportData, w)
 	case config.OutFormatHTML:
 		p = NewHTML(w)
 	case config.OutFormatJunitXML:
@@ -136,7 +141,11 @@ func (c *Printer) createPrinter(format string, w io.Writer) (issuePrinter, error
 	case config.OutFormatTeamCity:
 		p = NewTeamCity(w)
 	case config.OutFormatSarif:
-		p = NewSarif(w)
+		p = NewSarif(c.reportData, w)
+	case config.OutFormatRDJSON, config.OutFormatRDJSONL:
+		p = NewRDJSON(c.reportData, format == config.OutFormatRDJSONL, w)
+	case config.OutFormatMarkdown:
+		p = NewMarkdown(c.reportData, &c.cfg.Markdown, w)
 	default:
 		return nil, fmt.Errorf("unknown output format %q", format)
 	}
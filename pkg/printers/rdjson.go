@@ -0,0 +1,246 @@
+package printers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/snowmerak/golangci-lint/pkg/report"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+// The severities of the Reviewdog Diagnostic Format.
+const (
+	rdjsonSeverityUnknown = "UNKNOWN_SEVERITY"
+	rdjsonSeverityError   = "ERROR"
+	rdjsonSeverityWarning = "WARNING"
+	rdjsonSeverityInfo    = "INFO"
+)
+
+const defaultRDJSONSeverity = rdjsonSeverityError
+
+// rdjsonSeverities maps the usual severity names onto the severities of the format.
+var rdjsonSeverities = map[string]string{
+	"error":    rdjsonSeverityError,
+	"fatal":    rdjsonSeverityError,
+	"blocker":  rdjsonSeverityError,
+	"critical": rdjsonSeverityError,
+	"high":     rdjsonSeverityError,
+	"warning":  rdjsonSeverityWarning,
+	"major":    rdjsonSeverityWarning,
+	"medium":   rdjsonSeverityWarning,
+	"info":     rdjsonSeverityInfo,
+	"note":     rdjsonSeverityInfo,
+	"hint":     rdjsonSeverityInfo,
+	"minor":    rdjsonSeverityInfo,
+	"low":      rdjsonSeverityInfo,
+	"none":     rdjsonSeverityInfo,
+}
+
+// rdjsonDiagnosticResult is the output of the `rdjson` format.
+// https://github.com/reviewdog/reviewdog/tree/master/proto/rdf
+type rdjsonDiagnosticResult struct {
+	Source      *rdjsonSource      `json:"source,omitempty"`
+	Diagnostics []rdjsonDiagnostic `json:"diagnostics"`
+}
+
+// rdjsonDiagnostic is an issue: the `rdjsonl` format has one diagnostic per line.
+type rdjsonDiagnostic struct {
+	Message     string             `json:"message"`
+	Location    rdjsonLocation     `json:"location"`
+	Severity    string             `json:"severity,omitempty"`
+	Source      *rdjsonSource      `json:"source,omitempty"`
+	Code        *rdjsonCode        `json:"code,omitempty"`
+	Suggestions []rdjsonSuggestion `json:"suggestions,omitempty"`
+}
+
+type rdjsonSource struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+type rdjsonCode struct {
+	Value string `json:"value"`
+	URL   string `json:"url,omitempty"`
+}
+
+type rdjsonLocation struct {
+	Path  string       `json:"path"`
+	Range *rdjsonRange `json:"range,omitempty"`
+}
+
+// rdjsonRange is a range of a file: the end is exclusive.
+type rdjsonRange struct {
+	Start rdjsonPosition  `json:"start"`
+	End   *rdjsonPosition `json:"end,omitempty"`
+}
+
+// rdjsonPosition is a position with 1-based line and column (in bytes).
+type rdjsonPosition struct {
+	Line   int `json:"line"`
+	Column int `json:"column,omitempty"`
+}
+
+type rdjsonSuggestion struct {
+	Range rdjsonRange `json:"range"`
+	Text  string      `json:"text"`
+}
+
+// RDJSON prints the issues in the Reviewdog Diagnostic Format:
+// as a single document (`rdjson`) or as one diagnostic per line (`rdjsonl`).
+type RDJSON struct {
+	rd    *report.Data
+	lines bool
+	w     io.Writer
+}
+
+func NewRDJSON(rd *report.Data, lines bool, w io.Writer) *RDJSON {
+	return &RDJSON{
+		rd:    rd,
+		lines: lines,
+		w:     w,
+	}
+}
+
+func (p RDJSON) Print(issues []result.Issue) error {
+	sources := sourceFiles{}
+
+	diagnostics := make([]rdjsonDiagnostic, 0, len(issues))
+
+	for i := range issues {
+		diagnostics = append(diagnostics, p.buildDiagnostic(sources, &issues[i]))
+	}
+
+	encoder := json.NewEncoder(p.w)
+
+	if !p.lines {
+		return encoder.Encode(rdjsonDiagnosticResult{
+			Source:      &rdjsonSource{Name: "golangci-lint", URL: sarifInformationURI},
+			Diagnostics: diagnostics,
+		})
+	}
+
+	for i := range diagnostics {
+		if err := encoder.Encode(diagnostics[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p RDJSON) buildDiagnostic(sources sourceFiles, issue *result.Issue) rdjsonDiagnostic {
+	diagnostic := rdjsonDiagnostic{
+		Message:  issue.Text,
+		Location: rdjsonLocation{Path: issue.FilePath()},
+		Severity: rdjsonSeverity(issue.Severity),
+		Source:   &rdjsonSource{Name: issue.FromLinter},
+		Code:     &rdjsonCode{Value: issue.CheckName()},
+	}
+
+	if ld := p.rd.GetLinter(issue.FromLinter); ld != nil {
+		diagnostic.Code.URL = ld.URL
+	}
+
+	if issue.Line() > 0 {
+		rng := &rdjsonRange{Start: rdjsonPosition{Line: issue.Line(), Column: issue.Column()}}
+
+		// Without end, the range is the start position.
+		if issue.LineRange != nil && issue.LineRange.To > issue.Line() {
+			rng.End = &rdjsonPosition{Line: issue.LineRange.To + 1, Column: 1}
+		}
+
+		diagnostic.Location.Range = rng
+	}
+
+	diagnostic.Suggestions = buildRDJSONSuggestions(sources, issue)
+
+	return diagnostic
+}
+
+// buildRDJSONSuggestions converts the replacement of the issue to suggestions.
+// The text edits are expressed through byte offsets: they are dropped if the file can't be read.
+func buildRDJSONSuggestions(sources sourceFiles, issue *result.Issue) []rdjsonSuggestion {
+	r := issue.Replacement
+	if r == nil {
+		return nil
+	}
+
+	switch {
+	case len(r.TextEdits) > 0:
+		data := sources.data(issue.FilePath())
+		if data == nil {
+			return nil
+		}
+
+		var suggestions []rdjsonSuggestion
+
+		for _, edit := range r.TextEdits {
+			if edit.Pos < 0 || edit.Pos > edit.End || edit.End > len(data) {
+				return nil
+			}
+
+			start := offsetToRDJSONPosition(data, edit.Pos)
+			end := offsetToRDJSONPosition(data, edit.End)
+
+			suggestions = append(suggestions, rdjsonSuggestion{
+				Range: rdjsonRange{Start: start, End: &end},
+				Text:  edit.NewText,
+			})
+		}
+
+		return suggestions
+
+	case r.Inline != nil:
+		return []rdjsonSuggestion{{
+			Range: rdjsonRange{
+				Start: rdjsonPosition{Line: issue.Line(), Column: r.Inline.StartCol + 1},
+				End:   &rdjsonPosition{Line: issue.Line(), Column: r.Inline.StartCol + r.Inline.Length + 1},
+			},
+			Text: r.Inline.NewString,
+		}}
+
+	default:
+		lineRange := issue.GetLineRange()
+
+		// The whole lines are replaced, including their line breaks.
+		suggestion := rdjsonSuggestion{
+			Range: rdjsonRange{
+				Start: rdjsonPosition{Line: lineRange.From, Column: 1},
+				End:   &rdjsonPosition{Line: lineRange.To + 1, Column: 1},
+			},
+		}
+
+		if !r.NeedOnlyDelete && len(r.NewLines) > 0 {
+			suggestion.Text = strings.Join(r.NewLines, "\n") + "\n"
+		}
+
+		return []rdjsonSuggestion{suggestion}
+	}
+}
+
+// rdjsonSeverity maps the severity of the issue onto the severities of the format.
+func rdjsonSeverity(severity string) string {
+	if severity == "" {
+		return defaultRDJSONSeverity
+	}
+
+	if s, ok := rdjsonSeverities[strings.ToLower(severity)]; ok {
+		return s
+	}
+
+	return rdjsonSeverityUnknown
+}
+
+// offsetToRDJSONPosition converts a byte offset of the file to a position.
+func offsetToRDJSONPosition(data []byte, offset int) rdjsonPosition {
+	before := data[:offset]
+
+	lineStart := bytes.LastIndexByte(before, '\n') + 1
+
+	return rdjsonPosition{
+		Line:   bytes.Count(before, []byte("\n")) + 1,
+		Column: offset - lineStart + 1,
+	}
+}
@@ -0,0 +1,101 @@
+package printers
+
+import (
+	"bytes"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/snowmerak/golangci-lint/pkg/report"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+func TestRDJSON_Print(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "main.go")
+
+	err := os.WriteFile(filePath, []byte("package main\n\nvar a  = 1\n"), 0o600)
+	require.NoError(t, err)
+
+	data := &report.Data{}
+	data.AddLinter("linter-a", true, false, "The linter A.", "https://example.com/linter-a", nil)
+
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			RuleID:     "A001",
+			Severity:   "warning",
+			Text:       "some issue",
+			Replacement: &result.Replacement{
+				TextEdits: []result.TextEdit{{Pos: 19, End: 21, NewText: " "}},
+			},
+			Pos: token.Position{Filename: filePath, Line: 3, Column: 6},
+		},
+		{
+			FromLinter: "linter-b",
+			Text:       "another issue",
+			LineRange:  &result.Range{From: 300, To: 302},
+			Replacement: &result.Replacement{
+				NewLines: []string{"func foo() {}"},
+			},
+			Pos: token.Position{Filename: "path/to/fileb.go", Line: 300, Column: 9},
+		},
+		{
+			FromLinter: "linter-c",
+			Severity:   "low",
+			Text:       "issue c",
+			Replacement: &result.Replacement{
+				Inline: &result.InlineFix{StartCol: 1, Length: 3, NewString: "bar"},
+			},
+			Pos: token.Position{Filename: "path/to/filec.go", Line: 200},
+		},
+	}
+
+	diagnostics := []string{
+		`{"message":"some issue","location":{"path":"` + filePath + `","range":{"start":{"line":3,"column":6}}},"severity":"WARNING","source":{"name":"linter-a"},"code":{"value":"linter-a/A001","url":"https://example.com/linter-a"},"suggestions":[{"range":{"start":{"line":3,"column":6},"end":{"line":3,"column":8}},"text":" "}]}`,
+		`{"message":"another issue","location":{"path":"path/to/fileb.go","range":{"start":{"line":300,"column":9},"end":{"line":303,"column":1}}},"severity":"ERROR","source":{"name":"linter-b"},"code":{"value":"linter-b"},"suggestions":[{"range":{"start":{"line":300,"column":1},"end":{"line":303,"column":1}},"text":"func foo() {}\n"}]}`,
+		`{"message":"issue c","location":{"path":"path/to/filec.go","range":{"start":{"line":200}}},"severity":"INFO","source":{"name":"linter-c"},"code":{"value":"linter-c"},"suggestions":[{"range":{"start":{"line":200,"column":2},"end":{"line":200,"column":5}},"text":"bar"}]}`,
+	}
+
+	t.Run("rdjson", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+
+		err := NewRDJSON(data, false, buf).Print(issues)
+		require.NoError(t, err)
+
+		expected := `{"source":{"name":"golangci-lint","url":"https://golangci-lint.run"},"diagnostics":[` +
+			diagnostics[0] + "," + diagnostics[1] + "," + diagnostics[2] + "]}\n"
+
+		assert.Equal(t, expected, buf.String())
+	})
+
+	t.Run("rdjsonl", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+
+		err := NewRDJSON(data, true, buf).Print(issues)
+		require.NoError(t, err)
+
+		expected := diagnostics[0] + "\n" + diagnostics[1] + "\n" + diagnostics[2] + "\n"
+
+		assert.Equal(t, expected, buf.String())
+	})
+}
+
+func TestRDJSON_Print_empty(t *testing.T) {
+	buf := new(bytes.Buffer)
+
+	err := NewRDJSON(&report.Data{}, false, buf).Print(nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, `{"source":{"name":"golangci-lint","url":"https://golangci-lint.run"},"diagnostics":[]}`+"\n", buf.String())
+
+	buf.Reset()
+
+	err = NewRDJSON(&report.Data{}, true, buf).Print(nil)
+	require.NoError(t, err)
+
+	assert.Empty(t, buf.String())
+}
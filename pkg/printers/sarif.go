@@ -2,8 +2,12 @@ package printers
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
+	"sort"
+	"strings"
 
+	"github.com/snowmerak/golangci-lint/pkg/report"
 	"github.com/snowmerak/golangci-lint/pkg/result"
 )
 
@@ -12,6 +16,12 @@ const (
 	sarifSchemaURI = "https://schemastore.azurewebsites.net/schemas/json/sarif-2.1.0-rtm.6.json"
 )
 
+const sarifInformationURI = "https://golangci-lint.run"
+
+// The fingerprints are versioned: the consumers must not compare fingerprints computed differently.
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/errata01/os/sarif-v2.1.0-errata01-os-complete.html#_Toc141790876
+var sarifFingerprintKey = fmt.Sprintf("issueFingerprint/v%d", result.FingerprintVersion)
+
 type SarifOutput struct {
 	Version string     `json:"version"`
 	Schema  string     `json:"$schema"`
@@ -19,21 +29,45 @@ type SarifOutput struct {
 }
 
 type sarifRun struct {
-	Tool    sarifTool     `json:"tool"`
-	Results []sarifResult `json:"results"`
+	Tool        sarifTool         `json:"tool"`
+	Invocations []sarifInvocation `json:"invocations,omitempty"`
+	Results     []sarifResult     `json:"results"`
 }
 
 type sarifTool struct {
 	Driver struct {
-		Name string `json:"name"`
+		Name           string                     `json:"name"`
+		InformationURI string                     `json:"informationUri,omitempty"`
+		Rules          []sarifReportingDescriptor `json:"rules,omitempty"`
 	} `json:"driver"`
 }
 
+type sarifReportingDescriptor struct {
+	ID               string        `json:"id"`
+	ShortDescription *sarifMessage `json:"shortDescription,omitempty"`
+	HelpURI          string        `json:"helpUri,omitempty"`
+}
+
+type sarifInvocation struct {
+	ExecutionSuccessful        bool                `json:"executionSuccessful"`
+	ExitCode                   int                 `json:"exitCode"`
+	ToolExecutionNotifications []sarifNotification `json:"toolExecutionNotifications,omitempty"`
+}
+
+type sarifNotification struct {
+	Level   string       `json:"level"`
+	Message sarifMessage `json:"message"`
+}
+
 type sarifResult struct {
-	RuleID    string          `json:"ruleId"`
-	Level     string          `json:"level"`
-	Message   sarifMessage    `json:"message"`
-	Locations []sarifLocation `json:"locations"`
+	RuleID              string             `json:"ruleId"`
+	RuleIndex           int                `json:"ruleIndex"`
+	Level               string             `json:"level"`
+	Message             sarifMessage       `json:"message"`
+	Locations           []sarifLocation    `json:"locations"`
+	PartialFingerprints map[string]string  `json:"partialFingerprints,omitempty"`
+	Fixes               []sarifFix         `json:"fixes,omitempty"`
+	Suppressions        []sarifSuppression `json:"suppressions,omitempty"`
 }
 
 type sarifMessage struct {
@@ -55,25 +89,66 @@ type sarifArtifactLocation struct {
 }
 
 type sarifRegion struct {
-	StartLine   int `json:"startLine"`
-	StartColumn int `json:"startColumn"`
+	StartLine   int `json:"startLine,omitempty"`
+	StartColumn int `json:"startColumn,omitempty"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+
+	// The byte offsets are pointers because 0 is a valid offset.
+	ByteOffset *int `json:"byteOffset,omitempty"`
+	ByteLength *int `json:"byteLength,omitempty"`
+}
+
+type sarifFix struct {
+	ArtifactChanges []sarifArtifactChange `json:"artifactChanges"`
+}
+
+type sarifArtifactChange struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Replacements     []sarifReplacement    `json:"replacements"`
+}
+
+type sarifReplacement struct {
+	DeletedRegion   sarifRegion   `json:"deletedRegion"`
+	InsertedContent *sarifContent `json:"insertedContent,omitempty"`
+}
+
+type sarifContent struct {
+	Text string `json:"text"`
+}
+
+type sarifSuppression struct {
+	Kind          string `json:"kind"`
+	Justification string `json:"justification,omitempty"`
 }
 
 type Sarif struct {
-	w io.Writer
+	rd *report.Data
+	w  io.Writer
 }
 
-func NewSarif(w io.Writer) *Sarif {
-	return &Sarif{w: w}
+func NewSarif(rd *report.Data, w io.Writer) *Sarif {
+	return &Sarif{rd: rd, w: w}
 }
 
 func (p Sarif) Print(issues []result.Issue) error {
+	// The suppressed issues are reported as results with suppressions.
+	allIssues := append(append([]result.Issue{}, issues...), p.rd.Suppressed...)
+
 	run := sarifRun{}
 	run.Tool.Driver.Name = "golangci-lint"
+	run.Tool.Driver.InformationURI = sarifInformationURI
+	run.Tool.Driver.Rules = p.buildRules(allIssues)
+	run.Invocations = []sarifInvocation{p.buildInvocation()}
 	run.Results = make([]sarifResult, 0)
 
-	for i := range issues {
-		issue := issues[i]
+	ruleIndexes := map[string]int{}
+	for i, rule := range run.Tool.Driver.Rules {
+		ruleIndexes[rule.ID] = i
+	}
+
+	for i := range allIssues {
+		issue := &allIssues[i]
 
 		severity := issue.Severity
 
@@ -85,23 +160,43 @@ func (p Sarif) Print(issues []result.Issue) error {
 			severity = "error"
 		}
 
+		region := sarifRegion{
+			StartLine: issue.Line(),
+			// If startColumn is absent, it SHALL default to 1.
+			// https://docs.oasis-open.org/sarif/sarif/v2.1.0/errata01/os/sarif-v2.1.0-errata01-os-complete.html#_Toc141790941
+			StartColumn: max(1, issue.Column()),
+		}
+
+		// If endColumn is absent, the region ends at the end of endLine.
+		if issue.LineRange != nil && issue.LineRange.To > issue.Line() {
+			region.EndLine = issue.LineRange.To
+		}
+
 		sr := sarifResult{
-			RuleID:  issue.FromLinter,
-			Level:   severity,
-			Message: sarifMessage{Text: issue.Text},
+			RuleID:    issue.CheckName(),
+			RuleIndex: ruleIndexes[issue.CheckName()],
+			Level:     severity,
+			Message:   sarifMessage{Text: issue.Text},
 			Locations: []sarifLocation{
 				{
 					PhysicalLocation: sarifPhysicalLocation{
 						ArtifactLocation: sarifArtifactLocation{URI: issue.FilePath()},
-						Region: sarifRegion{
-							StartLine: issue.Line(),
-							// If startColumn is absent, it SHALL default to 1.
-							// https://docs.oasis-open.org/sarif/sarif/v2.1.0/errata01/os/sarif-v2.1.0-errata01-os-complete.html#_Toc141790941
-							StartColumn: max(1, issue.Column()),
-						},
+						Region:           region,
 					},
 				},
 			},
+			PartialFingerprints: map[string]string{sarifFingerprintKey: issue.Fingerprint()},
+		}
+
+		if fix := buildSarifFix(issue); fix != nil {
+			sr.Fixes = []sarifFix{*fix}
+		}
+
+		if issue.Suppression != nil {
+			sr.Suppressions = []sarifSuppression{{
+				Kind:          issue.Suppression.Kind,
+				Justification: issue.Suppression.Justification,
+			}}
 		}
 
 		run.Results = append(run.Results, sr)
@@ -115,3 +210,129 @@ func (p Sarif) Print(issues []result.Issue) error {
 
 	return json.NewEncoder(p.w).Encode(output)
 }
+
+// buildRules describes the checks that reported the issues.
+func (p Sarif) buildRules(issues []result.Issue) []sarifReportingDescriptor {
+	rules := map[string]sarifReportingDescriptor{}
+
+	for i := range issues {
+		issue := &issues[i]
+
+		if _, ok := rules[issue.CheckName()]; ok {
+			continue
+		}
+
+		rule := sarifReportingDescriptor{ID: issue.CheckName()}
+
+		if ld := p.rd.GetLinter(issue.FromLinter); ld != nil {
+			if ld.Desc != "" {
+				rule.ShortDescription = &sarifMessage{Text: ld.Desc}
+			}
+			rule.HelpURI = ld.URL
+		}
+
+		rules[rule.ID] = rule
+	}
+
+	descriptors := make([]sarifReportingDescriptor, 0, len(rules))
+	for _, rule := range rules {
+		descriptors = append(descriptors, rule)
+	}
+
+	sort.Slice(descriptors, func(i, j int) bool {
+		return descriptors[i].ID < descriptors[j].ID
+	})
+
+	return descriptors
+}
+
+func (p Sarif) buildInvocation() sarifInvocation {
+	invocation := sarifInvocation{
+		ExecutionSuccessful: p.rd.Error == "",
+		ExitCode:            p.rd.ExitCode,
+	}
+
+	for _, warning := range p.rd.Warnings {
+		text := warning.Text
+		if warning.Tag != "" {
+			text = fmt.Sprintf("[%s] %s", warning.Tag, warning.Text)
+		}
+
+		invocation.ToolExecutionNotifications = append(invocation.ToolExecutionNotifications,
+			sarifNotification{Level: "warning", Message: sarifMessage{Text: text}})
+	}
+
+	if p.rd.Error != "" {
+		invocation.ToolExecutionNotifications = append(invocation.ToolExecutionNotifications,
+			sarifNotification{Level: "error", Message: sarifMessage{Text: p.rd.Error}})
+	}
+
+	return invocation
+}
+
+// buildSarifFix converts the replacement of an issue into a SARIF fix.
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/errata01/os/sarif-v2.1.0-errata01-os-complete.html#_Toc141790955
+func buildSarifFix(issue *result.Issue) *sarifFix {
+	r := issue.Replacement
+	if r == nil {
+		return nil
+	}
+
+	var replacements []sarifReplacement
+
+	switch {
+	case len(r.TextEdits) > 0:
+		for _, edit := range r.TextEdits {
+			offset, length := edit.Pos, edit.End-edit.Pos
+
+			replacement := sarifReplacement{
+				DeletedRegion: sarifRegion{ByteOffset: &offset, ByteLength: &length},
+			}
+			if edit.NewText != "" {
+				replacement.InsertedContent = &sarifContent{Text: edit.NewText}
+			}
+
+			replacements = append(replacements, replacement)
+		}
+
+	case r.Inline != nil:
+		replacement := sarifReplacement{
+			DeletedRegion: sarifRegion{
+				StartLine:   issue.Line(),
+				StartColumn: r.Inline.StartCol + 1,
+				EndLine:     issue.Line(),
+				EndColumn:   r.Inline.StartCol + r.Inline.Length + 1,
+			},
+		}
+		if r.Inline.NewString != "" {
+			replacement.InsertedContent = &sarifContent{Text: r.Inline.NewString}
+		}
+
+		replacements = append(replacements, replacement)
+
+	default:
+		lineRange := issue.GetLineRange()
+
+		// The whole lines are replaced, including their line breaks.
+		replacement := sarifReplacement{
+			DeletedRegion: sarifRegion{
+				StartLine:   lineRange.From,
+				StartColumn: 1,
+				EndLine:     lineRange.To + 1,
+				EndColumn:   1,
+			},
+		}
+		if !r.NeedOnlyDelete && len(r.NewLines) > 0 {
+			replacement.InsertedContent = &sarifContent{Text: strings.Join(r.NewLines, "\n") + "\n"}
+		}
+
+		replacements = append(replacements, replacement)
+	}
+
+	return &sarifFix{
+		ArtifactChanges: []sarifArtifactChange{{
+			ArtifactLocation: sarifArtifactLocation{URI: issue.FilePath()},
+			Replacements:     replacements,
+		}},
+	}
+}
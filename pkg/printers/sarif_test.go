@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/snowmerak/golangci-lint/pkg/report"
 	"github.com/snowmerak/golangci-lint/pkg/result"
 )
 
@@ -65,12 +66,12 @@ func TestSarif_Print(t *testing.T) {
 
 	buf := new(bytes.Buffer)
 
-	printer := NewSarif(buf)
+	printer := NewSarif(&report.Data{}, buf)
 
 	err := printer.Print(issues)
 	require.NoError(t, err)
 
-	expected := `{"version":"2.1.0","$schema":"https://schemastore.azurewebsites.net/schemas/json/sarif-2.1.0-rtm.6.json","runs":[{"tool":{"driver":{"name":"golangci-lint"}},"results":[{"ruleId":"linter-a","level":"warning","message":{"text":"some issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filea.go","index":0},"region":{"startLine":10,"startColumn":4}}}]},{"ruleId":"linter-b","level":"error","message":{"text":"another issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/fileb.go","index":0},"region":{"startLine":300,"startColumn":9}}}]},{"ruleId":"linter-a","level":"error","message":{"text":"some issue 2"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filec.go","index":0},"region":{"startLine":11,"startColumn":5}}}]},{"ruleId":"linter-c","level":"error","message":{"text":"some issue without column"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filed.go","index":0},"region":{"startLine":11,"startColumn":1}}}]}]}]}
+	expected := `{"version":"2.1.0","$schema":"https://schemastore.azurewebsites.net/schemas/json/sarif-2.1.0-rtm.6.json","runs":[{"tool":{"driver":{"name":"golangci-lint","informationUri":"https://golangci-lint.run","rules":[{"id":"linter-a"},{"id":"linter-b"},{"id":"linter-c"}]}},"invocations":[{"executionSuccessful":true,"exitCode":0}],"results":[{"ruleId":"linter-a","ruleIndex":0,"level":"warning","message":{"text":"some issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filea.go","index":0},"region":{"startLine":10,"startColumn":4}}}],"partialFingerprints":{"issueFingerprint/v2":"CB5F8896796995E37AE3595392C70FAE"}},{"ruleId":"linter-b","ruleIndex":1,"level":"error","message":{"text":"another issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/fileb.go","index":0},"region":{"startLine":300,"startColumn":9}}}],"partialFingerprints":{"issueFingerprint/v2":"7BD7AD70E3B2A21119EFFD694529C8CA"}},{"ruleId":"linter-a","ruleIndex":0,"level":"error","message":{"text":"some issue 2"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filec.go","index":0},"region":{"startLine":11,"startColumn":5}}}],"partialFingerprints":{"issueFingerprint/v2":"830370514AABEB5F18A1634FAE0F977C"}},{"ruleId":"linter-c","ruleIndex":2,"level":"error","message":{"text":"some issue without column"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filed.go","index":0},"region":{"startLine":11,"startColumn":1}}}],"partialFingerprints":{"issueFingerprint/v2":"850A44CB9EBA2AE6A73DE160A1E28586"}}]}]}
 `
 
 	assert.Equal(t, expected, buf.String())
@@ -79,12 +80,78 @@ func TestSarif_Print(t *testing.T) {
 func TestSarif_Print_empty(t *testing.T) {
 	buf := new(bytes.Buffer)
 
-	printer := NewSarif(buf)
+	printer := NewSarif(&report.Data{}, buf)
 
 	err := printer.Print(nil)
 	require.NoError(t, err)
 
-	expected := `{"version":"2.1.0","$schema":"https://schemastore.azurewebsites.net/schemas/json/sarif-2.1.0-rtm.6.json","runs":[{"tool":{"driver":{"name":"golangci-lint"}},"results":[]}]}
+	expected := `{"version":"2.1.0","$schema":"https://schemastore.azurewebsites.net/schemas/json/sarif-2.1.0-rtm.6.json","runs":[{"tool":{"driver":{"name":"golangci-lint","informationUri":"https://golangci-lint.run"}},"invocations":[{"executionSuccessful":true,"exitCode":0}],"results":[]}]}
+`
+
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestSarif_Print_details(t *testing.T) {
+	data := &report.Data{
+		Warnings: []report.Warning{{Tag: "runner", Text: "some warning"}},
+		ExitCode: 1,
+	}
+	data.AddLinter("linter-a", true, false, "The linter A.", "https://example.com/linter-a", nil)
+	data.AddLinter("linter-b", true, false, "The linter B.", "", nil)
+
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			RuleID:     "A001",
+			Text:       "some issue",
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Line:     10,
+				Column:   4,
+			},
+			LineRange: &result.Range{From: 10, To: 12},
+			Replacement: &result.Replacement{
+				TextEdits: []result.TextEdit{{Pos: 0, End: 3, NewText: "foo"}},
+			},
+		},
+		{
+			FromLinter: "linter-b",
+			Text:       "another issue",
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Line:     3,
+				Column:   2,
+			},
+			Replacement: &result.Replacement{
+				Inline: &result.InlineFix{StartCol: 1, Length: 2},
+			},
+		},
+	}
+
+	data.Suppressed = []result.Issue{
+		{
+			FromLinter: "linter-b",
+			Text:       "suppressed issue",
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Line:     7,
+				Column:   1,
+			},
+			Replacement: &result.Replacement{
+				NewLines: []string{"bar"},
+			},
+			Suppression: &result.Suppression{Kind: result.SuppressionInSource, Justification: "false positive"},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	printer := NewSarif(data, buf)
+
+	err := printer.Print(issues)
+	require.NoError(t, err)
+
+	expected := `{"version":"2.1.0","$schema":"https://schemastore.azurewebsites.net/schemas/json/sarif-2.1.0-rtm.6.json","runs":[{"tool":{"driver":{"name":"golangci-lint","informationUri":"https://golangci-lint.run","rules":[{"id":"linter-a/A001","shortDescription":{"text":"The linter A."},"helpUri":"https://example.com/linter-a"},{"id":"linter-b","shortDescription":{"text":"The linter B."}}]}},"invocations":[{"executionSuccessful":true,"exitCode":1,"toolExecutionNotifications":[{"level":"warning","message":{"text":"[runner] some warning"}}]}],"results":[{"ruleId":"linter-a/A001","ruleIndex":0,"level":"error","message":{"text":"some issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filea.go","index":0},"region":{"startLine":10,"startColumn":4,"endLine":12}}}],"partialFingerprints":{"issueFingerprint/v2":"CB5F8896796995E37AE3595392C70FAE"},"fixes":[{"artifactChanges":[{"artifactLocation":{"uri":"path/to/filea.go","index":0},"replacements":[{"deletedRegion":{"byteOffset":0,"byteLength":3},"insertedContent":{"text":"foo"}}]}]}]},{"ruleId":"linter-b","ruleIndex":1,"level":"error","message":{"text":"another issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/fileb.go","index":0},"region":{"startLine":3,"startColumn":2}}}],"partialFingerprints":{"issueFingerprint/v2":"A01B08F5A9A1937DD79E23E107DD5B20"},"fixes":[{"artifactChanges":[{"artifactLocation":{"uri":"path/to/fileb.go","index":0},"replacements":[{"deletedRegion":{"startLine":3,"startColumn":2,"endLine":3,"endColumn":4}}]}]}]},{"ruleId":"linter-b","ruleIndex":1,"level":"error","message":{"text":"suppressed issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/fileb.go","index":0},"region":{"startLine":7,"startColumn":1}}}],"partialFingerprints":{"issueFingerprint/v2":"1555551444C121194AA62A85225FA2EB"},"fixes":[{"artifactChanges":[{"artifactLocation":{"uri":"path/to/fileb.go","index":0},"replacements":[{"deletedRegion":{"startLine":7,"startColumn":1,"endLine":8,"endColumn":1},"insertedContent":{"text":"bar\n"}}]}]}],"suppressions":[{"kind":"inSource","justification":"false positive"}]}]}]}
 `
 
 	assert.Equal(t, expected, buf.String())
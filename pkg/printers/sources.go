@@ -0,0 +1,52 @@
+package printers
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+
+	"github.com/snowmerak/golangci-lint/pkg/result"
+	"github.com/snowmerak/golangci-lint/pkg/result/processors"
+)
+
+// sourceFiles reads the files of the issues once.
+// A file can't be read if its path has a prefix (`output.path-prefix`) or if it has been removed.
+type sourceFiles map[string][]byte
+
+func (s sourceFiles) data(filePath string) []byte {
+	data, ok := s[filePath]
+	if !ok {
+		data, _ = os.ReadFile(filePath)
+		s[filePath] = data
+	}
+
+	return data
+}
+
+// fix returns the unified diff of the replacement of the issue, or an empty string.
+func (s sourceFiles) fix(issue *result.Issue) string {
+	if issue.Replacement == nil {
+		return ""
+	}
+
+	data := s.data(issue.FilePath())
+	if data == nil {
+		return ""
+	}
+
+	edits, err := processors.ReplacementTextEdits(issue, data)
+	if err != nil {
+		return ""
+	}
+
+	fixed := result.ApplyTextEdits(data, edits)
+
+	filePath := issue.FilePath()
+
+	diffEdits := myers.ComputeEdits(span.URIFromPath(filePath), string(data), string(fixed))
+
+	return fmt.Sprint(gotextdiff.ToUnified("a/"+filePath, "b/"+filePath, string(data), diffEdits))
+}
@@ -0,0 +1,214 @@
+package printers
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/snowmerak/golangci-lint/pkg/report"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+// Template prints the issues with a user-defined text/template file (`template:<path>` format).
+type Template struct {
+	rd   *report.Data
+	tmpl *template.Template
+	w    io.Writer
+}
+
+// TemplateData is the data of the templates.
+type TemplateData struct {
+	Issues []result.Issue
+	// The linters and the warnings of the run.
+	Report *report.Data
+}
+
+// TemplateGroup is a group of issues returned by the `groupBy` template function.
+type TemplateGroup struct {
+	Key    string
+	Issues []result.Issue
+}
+
+func NewTemplate(rd *report.Data, path string, w io.Writer) (*Template, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("can't read template: %w", err)
+	}
+
+	tmpl, err := template.New(filepath.Base(path)).Funcs(templateFuncs()).Parse(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("can't parse template %s: %w", path, err)
+	}
+
+	return &Template{
+		rd:   rd,
+		tmpl: tmpl,
+		w:    w,
+	}, nil
+}
+
+func (p Template) Print(issues []result.Issue) error {
+	data := TemplateData{
+		Issues: issues,
+		Report: p.rd,
+	}
+	if data.Issues == nil {
+		data.Issues = []result.Issue{}
+	}
+
+	// The output isn't written if the execution fails.
+	var buf bytes.Buffer
+	if err := p.tmpl.Execute(&buf, data); err != nil {
+		return fmt.Errorf("can't execute template: %w", err)
+	}
+
+	_, err := buf.WriteTo(p.w)
+
+	return err
+}
+
+func templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		// Escaping.
+		"xml":   xmlEscape,
+		"json":  jsonEncode,
+		"shell": shellQuote,
+
+		// Paths.
+		"relPath": relPath,
+		"absPath": filepath.Abs,
+		"toSlash": filepath.ToSlash,
+
+		// Severities.
+		"mapSeverity": mapSeverity,
+
+		// Grouping.
+		"groupBy": groupBy,
+
+		// Misc.
+		"dict":  dict,
+		"lower": strings.ToLower,
+		"upper": strings.ToUpper,
+		"join":  strings.Join,
+	}
+}
+
+// xmlEscape escapes a text for XML attributes and elements.
+func xmlEscape(s string) (string, error) {
+	var buf bytes.Buffer
+	if err := xml.EscapeText(&buf, []byte(s)); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// jsonEncode encodes a value to JSON: a string is quoted.
+func jsonEncode(v any) (string, error) {
+	var buf bytes.Buffer
+
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(v); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// shellQuote quotes a text for a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// relPath returns the path relative to the base directory, with slashes.
+func relPath(base, path string) (string, error) {
+	absBase, err := filepath.Abs(base)
+	if err != nil {
+		return "", err
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	rel, err := filepath.Rel(absBase, absPath)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.ToSlash(rel), nil
+}
+
+// mapSeverity returns the value of the severity inside the mapping, or the default value.
+// ex: {{ mapSeverity .Severity (dict "error" "HIGH" "warning" "MEDIUM") "LOW" }}.
+func mapSeverity(severity string, mapping map[string]any, defaultValue any) any {
+	if value, ok := mapping[severity]; ok {
+		return value
+	}
+
+	return defaultValue
+}
+
+// groupBy groups the issues by file, linter or severity, in the order of the first issue of each group.
+func groupBy(key string, issues []result.Issue) ([]TemplateGroup, error) {
+	var keyOf func(issue *result.Issue) string
+
+	switch key {
+	case "file":
+		keyOf = func(issue *result.Issue) string { return issue.FilePath() }
+	case "linter":
+		keyOf = func(issue *result.Issue) string { return issue.FromLinter }
+	case "severity":
+		keyOf = func(issue *result.Issue) string { return issue.Severity }
+	default:
+		return nil, fmt.Errorf("unknown group key %q: only file, linter and severity are allowed", key)
+	}
+
+	var groups []TemplateGroup
+
+	index := map[string]int{}
+
+	for i := range issues {
+		k := keyOf(&issues[i])
+
+		if j, ok := index[k]; ok {
+			groups[j].Issues = append(groups[j].Issues, issues[i])
+			continue
+		}
+
+		index[k] = len(groups)
+		groups = append(groups, TemplateGroup{Key: k, Issues: []result.Issue{issues[i]}})
+	}
+
+	return groups, nil
+}
+
+// dict creates a map from key-value pairs: {{ dict "key1" value1 "key2" value2 }}.
+func dict(pairs ...any) (map[string]any, error) {
+	if len(pairs)%2 != 0 {
+		return nil, errors.New("dict requires key-value pairs")
+	}
+
+	m := make(map[string]any, len(pairs)/2)
+
+	for i := 0; i < len(pairs); i += 2 {
+		key, ok := pairs[i].(string)
+		if !ok {
+			return nil, fmt.Errorf("dict keys must be strings: %v", pairs[i])
+		}
+
+		m[key] = pairs[i+1]
+	}
+
+	return m, nil
+}
@@ -0,0 +1,145 @@
+package printers
+
+import (
+	"bytes"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/snowmerak/golangci-lint/pkg/report"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+func TestTemplate_Print(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Severity:   "error",
+			Text:       `some "issue" <here>`,
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Line:     10,
+				Column:   4,
+			},
+		},
+		{
+			FromLinter: "linter-b",
+			Severity:   "warning",
+			Text:       "it's another issue",
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Line:     300,
+				Column:   9,
+			},
+		},
+		{
+			FromLinter: "linter-a",
+			Text:       "another issue",
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Line:     12,
+			},
+		},
+	}
+
+	rd := &report.Data{
+		Warnings: []report.Warning{{Tag: "runner", Text: "some warning"}},
+		Linters: []report.LinterData{
+			{Name: "linter-a", Enabled: true},
+			{Name: "linter-b", Enabled: true},
+			{Name: "linter-c"},
+		},
+	}
+
+	testCases := []struct {
+		desc     string
+		tmpl     string
+		expected string
+	}{
+		{
+			desc: "escaping",
+			tmpl: `{{ range .Issues }}{{ xml .Text }}|{{ json .Text }}|{{ shell .Text }}` + "\n" + `{{ end }}`,
+			expected: `some &#34;issue&#34; &lt;here&gt;|"some \"issue\" <here>"|'some "issue" <here>'` + "\n" +
+				`it&#39;s another issue|"it's another issue"|'it'\''s another issue'` + "\n" +
+				`another issue|"another issue"|'another issue'` + "\n",
+		},
+		{
+			desc:     "paths",
+			tmpl:     `{{ range .Issues }}{{ relPath "path" .FilePath }} {{ end }}`,
+			expected: "to/filea.go to/fileb.go to/filea.go ",
+		},
+		{
+			desc:     "severities",
+			tmpl:     `{{ $m := dict "error" "HIGH" "warning" "MEDIUM" }}{{ range .Issues }}{{ mapSeverity .Severity $m "LOW" }} {{ end }}`,
+			expected: "HIGH MEDIUM LOW ",
+		},
+		{
+			desc:     "group by file",
+			tmpl:     `{{ range groupBy "file" .Issues }}{{ .Key }}:{{ range .Issues }} {{ .Line }}{{ end }}` + "\n" + `{{ end }}`,
+			expected: "path/to/filea.go: 10 12\npath/to/fileb.go: 300\n",
+		},
+		{
+			desc:     "group by linter",
+			tmpl:     `{{ range groupBy "linter" .Issues }}{{ upper .Key }}={{ len .Issues }} {{ end }}`,
+			expected: "LINTER-A=2 LINTER-B=1 ",
+		},
+		{
+			desc:     "report",
+			tmpl:     `{{ range .Report.Linters }}{{ if .Enabled }}{{ .Name }} {{ end }}{{ end }}{{ range .Report.Warnings }}{{ .Tag }}: {{ .Text }}{{ end }}`,
+			expected: "linter-a linter-b runner: some warning",
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			buf := new(bytes.Buffer)
+
+			printer, err := NewTemplate(rd, writeTemplate(t, test.tmpl), buf)
+			require.NoError(t, err)
+
+			err = printer.Print(issues)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, buf.String())
+		})
+	}
+}
+
+func TestNewTemplate_error(t *testing.T) {
+	_, err := NewTemplate(&report.Data{}, writeTemplate(t, `{{ range .Issues }}`), new(bytes.Buffer))
+	require.Error(t, err)
+
+	_, err = NewTemplate(&report.Data{}, filepath.Join(t.TempDir(), "missing.tmpl"), new(bytes.Buffer))
+	require.Error(t, err)
+}
+
+func TestTemplate_Print_error(t *testing.T) {
+	buf := new(bytes.Buffer)
+
+	printer, err := NewTemplate(&report.Data{}, writeTemplate(t, `before{{ groupBy "column" .Issues }}`), buf)
+	require.NoError(t, err)
+
+	err = printer.Print(nil)
+	require.ErrorContains(t, err, `unknown group key "column"`)
+
+	// The output isn't partially written.
+	assert.Empty(t, buf.String())
+}
+
+func writeTemplate(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "report.tmpl")
+
+	err := os.WriteFile(path, []byte(content), 0o600)
+	require.NoError(t, err)
+
+	return path
+}
@@ -1,5 +1,7 @@
 package report
 
+import "github.com/snowmerak/golangci-lint/pkg/result"
+
 type Warning struct {
 	Tag  string `json:",omitempty"`
 	Text string
@@ -9,18 +11,60 @@ type LinterData struct {
 	Name             string
 	Enabled          bool `json:",omitempty"`
 	EnabledByDefault bool `json:",omitempty"`
+
+	// Only used by the printers that describe the linters (ex: SARIF).
+	Desc string `json:"-"`
+	URL  string `json:"-"`
+
+	// Only used by the printers that categorize the issues (ex: Code Climate).
+	Presets []string `json:"-"`
+}
+
+// ModuleData is the status of a module analyzed by a multi-module run.
+type ModuleData struct {
+	Path string
+	Dir  string
+
+	// The configuration file used for the module.
+	Config string `json:",omitempty"`
+
+	Packages int
+	Issues   int
+	Error    string `json:",omitempty"`
 }
 
 type Data struct {
 	Warnings []Warning    `json:",omitempty"`
 	Linters  []LinterData `json:",omitempty"`
+	Modules  []ModuleData `json:",omitempty"`
 	Error    string       `json:",omitempty"`
+
+	// ExitCode is the exit code of the run, known before printing the issues.
+	ExitCode int `json:"-"`
+
+	// Suppressed contains the issues suppressed by nolint directives and by the suppressions file,
+	// only collected when `output.print-suppressed` is enabled.
+	Suppressed []result.Issue `json:"-"`
 }
 
-func (d *Data) AddLinter(name string, enabled, enabledByDefault bool) {
+func (d *Data) AddLinter(name string, enabled, enabledByDefault bool, desc, url string, presets []string) {
 	d.Linters = append(d.Linters, LinterData{
 		Name:             name,
 		Enabled:          enabled,
 		EnabledByDefault: enabledByDefault,
+		Desc:             desc,
+		URL:              url,
+		Presets:          presets,
 	})
 }
+
+// GetLinter returns the data of a linter, or nil if the linter is unknown.
+func (d *Data) GetLinter(name string) *LinterData {
+	for i := range d.Linters {
+		if d.Linters[i].Name == name {
+			return &d.Linters[i]
+		}
+	}
+
+	return nil
+}
@@ -1,13 +1,20 @@
 package result
 
 import (
-	"crypto/md5" //nolint:gosec // for md5 hash
+	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"go/token"
+	"path/filepath"
+	"strings"
 
 	"golang.org/x/tools/go/packages"
 )
 
+// FingerprintVersion is the version of the fingerprint scheme.
+// It must be incremented each time the computation of the fingerprints changes.
+const FingerprintVersion = 2
+
 type Range struct {
 	From, To int
 }
@@ -16,6 +23,34 @@ type Replacement struct {
 	NeedOnlyDelete bool     // need to delete all lines of the issue without replacement with new lines
 	NewLines       []string // if NeedDelete is false it's the replacement lines
 	Inline         *InlineFix
+
+	// TextEdits are applied to the file of the issue.
+	// The other fields are converted to text edits by the fixer.
+	TextEdits []TextEdit `json:",omitempty"`
+}
+
+// TextEdit replaces the bytes [Pos, End) of a file with NewText.
+// An insertion has Pos == End.
+type TextEdit struct {
+	Pos     int // zero-based byte offset
+	End     int // zero-based byte offset, exclusive
+	NewText string
+}
+
+// ApplyTextEdits applies sorted and not intersecting edits to data.
+func ApplyTextEdits(data []byte, edits []TextEdit) []byte {
+	var buf bytes.Buffer
+	buf.Grow(len(data))
+
+	cur := 0
+	for _, edit := range edits {
+		buf.Write(data[cur:edit.Pos])
+		buf.WriteString(edit.NewText)
+		cur = edit.End
+	}
+	buf.Write(data[cur:])
+
+	return buf.Bytes()
 }
 
 type InlineFix struct {
@@ -24,10 +59,29 @@ type InlineFix struct {
 	NewString string
 }
 
+// The kinds of suppressions (the names are the ones of SARIF).
+const (
+	// SuppressionInSource is a suppression by a nolint directive.
+	SuppressionInSource = "inSource"
+	// SuppressionExternal is a suppression by an entry of the suppressions file.
+	SuppressionExternal = "external"
+)
+
+// Suppression describes why an issue is suppressed.
+type Suppression struct {
+	// Kind is where the issue is suppressed: SuppressionInSource or SuppressionExternal.
+	Kind string `json:",omitempty"`
+	// Justification is the reason given by the user (ex: the comment after a nolint directive).
+	Justification string `json:",omitempty"`
+}
+
 type Issue struct {
 	FromLinter string
 	Text       string
 
+	// RuleID is the stable identifier of the check of the linter that reported the issue (ex: SA4011, G104).
+	RuleID string `json:",omitempty"`
+
 	Severity string
 
 	// Source lines of a code with the issue to show
@@ -46,6 +100,16 @@ type Issue struct {
 	// HunkPos is used only when golangci-lint is run over a diff
 	HunkPos int `json:",omitempty"`
 
+	// Symbol is the name of the declaration containing the issue (function, method, type, etc.).
+	Symbol string `json:",omitempty"`
+
+	// Occurrence is the index of the issue among the issues with the same content inside the same declaration.
+	Occurrence int `json:",omitempty"`
+
+	// Suppression is set only on the issues suppressed by a nolint directive or by the suppressions file,
+	// when they are kept for the output.
+	Suppression *Suppression `json:",omitempty"`
+
 	// If we are expecting a nolint (because this is from nolintlint), record the expected linter
 	ExpectNoLint         bool
 	ExpectedNoLintLinter string
@@ -81,18 +145,45 @@ func (i *Issue) GetLineRange() Range {
 	return *i.LineRange
 }
 
+// CheckName returns the name of the check that reported the issue: `linter/rule` or `linter`.
+func (i *Issue) CheckName() string {
+	if i.RuleID == "" {
+		return i.FromLinter
+	}
+
+	return i.FromLinter + "/" + i.RuleID
+}
+
 func (i *Issue) Description() string {
 	return fmt.Sprintf("%s: %s", i.FromLinter, i.Text)
 }
 
+// Fingerprint identifies an issue independently of its position:
+// it doesn't change when lines are added or removed around the issue, or when the file is renamed inside its package.
+// The issues with the same content inside the same declaration are distinguished by their occurrence index.
 func (i *Issue) Fingerprint() string {
-	firstLine := ""
-	if len(i.SourceLines) > 0 {
-		firstLine = i.SourceLines[0]
+	hash := sha256.New()
+	_, _ = fmt.Fprintf(hash, "%d\x00%s\x00%s\x00%s\x00%s\x00%s\x00%d",
+		FingerprintVersion, i.FromLinter, i.Text, i.fingerprintScope(), i.Symbol, normalizeSource(i.SourceLines), i.Occurrence)
+
+	return fmt.Sprintf("%X", hash.Sum(nil)[:16])
+}
+
+// fingerprintScope returns the package of the issue, or its directory if the package is unknown.
+func (i *Issue) fingerprintScope() string {
+	if i.Pkg != nil && i.Pkg.PkgPath != "" {
+		return i.Pkg.PkgPath
 	}
 
-	hash := md5.New() //nolint:gosec // we don't need a strong hash here
-	_, _ = fmt.Fprintf(hash, "%s%s%s", i.Pos.Filename, i.Text, firstLine)
+	return filepath.ToSlash(filepath.Dir(i.FilePath()))
+}
+
+// normalizeSource removes the indentation and the repeated whitespaces of the source lines.
+func normalizeSource(lines []string) string {
+	normalized := make([]string, 0, len(lines))
+	for _, line := range lines {
+		normalized = append(normalized, strings.Join(strings.Fields(line), " "))
+	}
 
-	return fmt.Sprintf("%X", hash.Sum(nil))
+	return strings.Join(normalized, "\n")
 }
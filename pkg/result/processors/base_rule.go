@@ -2,6 +2,7 @@ package processors
 
 import (
 	"regexp"
+	"slices"
 
 	"github.com/snowmerak/golangci-lint/pkg/fsutils"
 	"github.com/snowmerak/golangci-lint/pkg/logutils"
@@ -16,10 +17,11 @@ type baseRule struct {
 	path       *regexp.Regexp
 	pathExcept *regexp.Regexp
 	linters    []string
+	ruleIDs    []string
 }
 
 func (r *baseRule) isEmpty() bool {
-	return r.text == nil && r.source == nil && r.path == nil && r.pathExcept == nil && len(r.linters) == 0
+	return r.text == nil && r.source == nil && r.path == nil && r.pathExcept == nil && len(r.linters) == 0 && len(r.ruleIDs) == 0
 }
 
 func (r *baseRule) match(issue *result.Issue, files *fsutils.Files, log logutils.Log) bool {
@@ -38,6 +40,9 @@ func (r *baseRule) match(issue *result.Issue, files *fsutils.Files, log logutils
 	if len(r.linters) != 0 && !r.matchLinter(issue) {
 		return false
 	}
+	if len(r.ruleIDs) != 0 && !slices.Contains(r.ruleIDs, issue.RuleID) {
+		return false
+	}
 
 	// the most heavyweight checking last
 	if r.source != nil && !r.matchSource(issue, files.LineCache, log) {
@@ -0,0 +1,182 @@
+package processors
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+// The baseline depends on the fingerprints of the issues.
+const baselineVersion = result.FingerprintVersion
+
+var _ Processor = (*Baseline)(nil)
+
+type baselineFile struct {
+	Version int             `json:"version"`
+	Issues  []baselineIssue `json:"issues"`
+}
+
+type baselineIssue struct {
+	Fingerprint string `json:"fingerprint"`
+	FromLinter  string `json:"linter"`
+	File        string `json:"file"`
+	Line        int    `json:"line"`
+	Text        string `json:"text"`
+}
+
+// Baseline hides the issues that are present in a baseline file,
+// and writes the baseline file from the current issues.
+type Baseline struct {
+	log logutils.Log
+
+	writePath string
+
+	// the baseline entries not matched yet, by fingerprint
+	remaining map[string][]baselineIssue
+
+	current []baselineIssue
+}
+
+func NewBaseline(log logutils.Log, cfg *config.Issues) (*Baseline, error) {
+	p := &Baseline{
+		log:       log,
+		writePath: cfg.BaselineWrite,
+	}
+
+	if cfg.Baseline == "" {
+		return p, nil
+	}
+
+	baseline, err := readBaselineFile(cfg.Baseline)
+	if err != nil {
+		return nil, err
+	}
+
+	p.remaining = map[string][]baselineIssue{}
+	for _, issue := range baseline.Issues {
+		p.remaining[issue.Fingerprint] = append(p.remaining[issue.Fingerprint], issue)
+	}
+
+	return p, nil
+}
+
+func (*Baseline) Name() string {
+	return "baseline"
+}
+
+func (p *Baseline) Process(issues []result.Issue) ([]result.Issue, error) {
+	if p.remaining == nil && p.writePath == "" {
+		return issues, nil
+	}
+
+	return filterIssues(issues, func(issue *result.Issue) bool {
+		fingerprint := issue.Fingerprint()
+
+		if p.writePath != "" {
+			p.current = append(p.current, baselineIssue{
+				Fingerprint: fingerprint,
+				FromLinter:  issue.FromLinter,
+				File:        issue.FilePath(),
+				Line:        issue.Line(),
+				Text:        issue.Text,
+			})
+		}
+
+		// an entry hides only one issue: the duplicated issues are counted.
+		entries := p.remaining[fingerprint]
+		if len(entries) == 0 {
+			return true
+		}
+
+		p.remaining[fingerprint] = entries[1:]
+
+		return false
+	}), nil
+}
+
+func (p *Baseline) Finish() {
+	if p.remaining != nil {
+		p.reportStaleEntries()
+	}
+
+	if p.writePath == "" {
+		return
+	}
+
+	err := writeBaselineFile(p.writePath, p.current)
+	if err != nil {
+		p.log.Errorf("Failed to write baseline: %v", err)
+		return
+	}
+
+	p.log.Infof("Baseline with %d issues written to %s", len(p.current), p.writePath)
+}
+
+func (p *Baseline) reportStaleEntries() {
+	var stale []baselineIssue
+	for _, entries := range p.remaining {
+		stale = append(stale, entries...)
+	}
+
+	if len(stale) == 0 {
+		return
+	}
+
+	sortBaselineIssues(stale)
+
+	for _, entry := range stale {
+		p.log.Infof("Baseline entry no longer occurs: %s:%d: %s: %s", entry.File, entry.Line, entry.FromLinter, entry.Text)
+	}
+
+	p.log.Warnf("%d baseline entries no longer occur, the baseline can be updated with --baseline-write", len(stale))
+}
+
+func readBaselineFile(filename string) (*baselineFile, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read baseline: %w", err)
+	}
+
+	var baseline baselineFile
+	if err = json.Unmarshal(data, &baseline); err != nil {
+		return nil, fmt.Errorf("failed to parse baseline %s: %w", filename, err)
+	}
+
+	if baseline.Version != baselineVersion {
+		return nil, fmt.Errorf("unsupported baseline version %d in %s, it should be re-generated", baseline.Version, filename)
+	}
+
+	return &baseline, nil
+}
+
+func writeBaselineFile(filename string, issues []baselineIssue) error {
+	sortBaselineIssues(issues)
+
+	if issues == nil {
+		issues = []baselineIssue{}
+	}
+
+	data, err := json.MarshalIndent(baselineFile{Version: baselineVersion, Issues: issues}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, append(data, '\n'), 0o644)
+}
+
+func sortBaselineIssues(issues []baselineIssue) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].File != issues[j].File {
+			return issues[i].File < issues[j].File
+		}
+		if issues[i].Line != issues[j].Line {
+			return issues[i].Line < issues[j].Line
+		}
+		return issues[i].Fingerprint < issues[j].Fingerprint
+	})
+}
@@ -0,0 +1,79 @@
+package processors
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+func newBaselineIssue(linter, text string, line int) result.Issue {
+	return result.Issue{
+		FromLinter:  linter,
+		Text:        text,
+		Pos:         token.Position{Filename: "a.go", Line: line},
+		SourceLines: []string{text},
+	}
+}
+
+func newTestBaseline(t *testing.T, cfg *config.Issues) *Baseline {
+	t.Helper()
+
+	p, err := NewBaseline(logutils.NewStderrLog(logutils.DebugKeyEmpty), cfg)
+	require.NoError(t, err)
+
+	return p
+}
+
+func TestBaseline(t *testing.T) {
+	baselinePath := filepath.Join(t.TempDir(), "baseline.json")
+
+	writer := newTestBaseline(t, &config.Issues{BaselineWrite: baselinePath})
+
+	processAssertSame(t, writer,
+		newBaselineIssue("linter-a", "old", 1),
+		newBaselineIssue("linter-a", "dup", 2),
+		newBaselineIssue("linter-b", "fixed", 3),
+	)
+	writer.Finish()
+
+	reader := newTestBaseline(t, &config.Issues{Baseline: baselinePath})
+
+	issues := process(t, reader,
+		newBaselineIssue("linter-a", "old", 10),
+		newBaselineIssue("linter-a", "dup", 11),
+		newBaselineIssue("linter-a", "dup", 12),
+		newBaselineIssue("linter-b", "new", 13),
+	)
+
+	assert.Equal(t, []result.Issue{
+		newBaselineIssue("linter-a", "dup", 12),
+		newBaselineIssue("linter-b", "new", 13),
+	}, issues)
+
+	fixed := newBaselineIssue("linter-b", "fixed", 3)
+
+	assert.Len(t, reader.remaining[fixed.Fingerprint()], 1)
+}
+
+func TestBaseline_invalid(t *testing.T) {
+	baselinePath := filepath.Join(t.TempDir(), "baseline.json")
+
+	err := os.WriteFile(baselinePath, []byte(`{"version": 0, "issues": []}`), 0o600)
+	require.NoError(t, err)
+
+	_, err = NewBaseline(logutils.NewStderrLog(logutils.DebugKeyEmpty),
+		&config.Issues{Baseline: baselinePath})
+	require.Error(t, err)
+
+	_, err = NewBaseline(logutils.NewStderrLog(logutils.DebugKeyEmpty),
+		&config.Issues{Baseline: filepath.Join(t.TempDir(), "missing.json")})
+	require.Error(t, err)
+}
@@ -15,6 +15,10 @@ type Exclude struct {
 	name string
 
 	pattern *regexp.Regexp
+
+	// The patterns, to count the issues excluded by each pattern.
+	patterns []*regexp.Regexp
+	exclusionsCounter
 }
 
 func NewExclude(cfg *config.Issues) *Exclude {
@@ -35,21 +39,37 @@ func NewExclude(cfg *config.Issues) *Exclude {
 		p.pattern = regexp.MustCompile(prefix + pattern)
 	}
 
+	for i, pattern := range cfg.ExcludePatterns {
+		p.patterns = append(p.patterns, regexp.MustCompile(prefix+pattern))
+		p.add("exclude pattern", "issues.exclude", i, pattern)
+	}
+
 	return p
 }
 
-func (p Exclude) Name() string {
+func (p *Exclude) Name() string {
 	return p.name
 }
 
-func (p Exclude) Process(issues []result.Issue) ([]result.Issue, error) {
+func (p *Exclude) Process(issues []result.Issue) ([]result.Issue, error) {
 	if p.pattern == nil {
 		return issues, nil
 	}
 
 	return filterIssues(issues, func(issue *result.Issue) bool {
-		return !p.pattern.MatchString(issue.Text)
+		if !p.pattern.MatchString(issue.Text) {
+			return true
+		}
+
+		for i, pattern := range p.patterns {
+			if pattern.MatchString(issue.Text) {
+				p.match(i)
+				break
+			}
+		}
+
+		return false
 	}), nil
 }
 
-func (Exclude) Finish() {}
+func (*Exclude) Finish() {}
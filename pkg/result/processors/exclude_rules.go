@@ -2,6 +2,7 @@ package processors
 
 import (
 	"regexp"
+	"slices"
 
 	"github.com/snowmerak/golangci-lint/pkg/config"
 	"github.com/snowmerak/golangci-lint/pkg/fsutils"
@@ -22,6 +23,7 @@ type ExcludeRules struct {
 	files *fsutils.Files
 
 	rules []excludeRule
+	exclusionsCounter
 }
 
 func NewExcludeRules(log logutils.Log, files *fsutils.Files, cfg *config.Issues) *ExcludeRules {
@@ -37,7 +39,11 @@ func NewExcludeRules(log logutils.Log, files *fsutils.Files, cfg *config.Issues)
 		p.name = "exclude-rules-case-sensitive"
 	}
 
-	excludeRules := cfg.ExcludeRules
+	excludeRules := slices.Clone(cfg.ExcludeRules)
+
+	for i := range excludeRules {
+		p.add("exclude rule", "issues.exclude-rules", i, describeRule(&excludeRules[i].BaseRule))
+	}
 
 	if cfg.UseDefaultExcludes {
 		for _, r := range config.GetExcludePatterns(cfg.IncludeDefaultExcludes) {
@@ -47,6 +53,8 @@ func NewExcludeRules(log logutils.Log, files *fsutils.Files, cfg *config.Issues)
 					Linters: []string{r.Linter},
 				},
 			})
+
+			p.add("default exclusion", "issues.include", -1, r.ID)
 		}
 	}
 
@@ -55,17 +63,18 @@ func NewExcludeRules(log logutils.Log, files *fsutils.Files, cfg *config.Issues)
 	return p
 }
 
-func (p ExcludeRules) Name() string { return p.name }
+func (p *ExcludeRules) Name() string { return p.name }
 
-func (p ExcludeRules) Process(issues []result.Issue) ([]result.Issue, error) {
+func (p *ExcludeRules) Process(issues []result.Issue) ([]result.Issue, error) {
 	if len(p.rules) == 0 {
 		return issues, nil
 	}
 
 	return filterIssues(issues, func(issue *result.Issue) bool {
-		for _, rule := range p.rules {
+		for i, rule := range p.rules {
 			rule := rule
 			if rule.match(issue, p.files, p.log) {
+				p.match(i)
 				return false
 			}
 		}
@@ -74,7 +83,7 @@ func (p ExcludeRules) Process(issues []result.Issue) ([]result.Issue, error) {
 	}), nil
 }
 
-func (ExcludeRules) Finish() {}
+func (*ExcludeRules) Finish() {}
 
 func createRules(rules []config.ExcludeRule, prefix string) []excludeRule {
 	parsedRules := make([]excludeRule, 0, len(rules))
@@ -82,6 +91,7 @@ func createRules(rules []config.ExcludeRule, prefix string) []excludeRule {
 	for _, rule := range rules {
 		parsedRule := excludeRule{}
 		parsedRule.linters = rule.Linters
+		parsedRule.ruleIDs = rule.RuleIDs
 
 		if rule.Text != "" {
 			parsedRule.text = regexp.MustCompile(prefix + rule.Text)
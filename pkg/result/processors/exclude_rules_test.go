@@ -170,6 +170,43 @@ func TestExcludeRules_text(t *testing.T) {
 	assert.Equal(t, texts[1:], processedTexts)
 }
 
+func TestExcludeRules_ruleIDs(t *testing.T) {
+	opts := &config.Issues{
+		ExcludeRules: []config.ExcludeRule{
+			{
+				BaseRule: config.BaseRule{
+					RuleIDs: []string{"SA4011", "SA1019"},
+					Linters: []string{"staticcheck"},
+				},
+			},
+		},
+	}
+
+	p := NewExcludeRules(nil, nil, opts)
+
+	cases := []struct {
+		linter, ruleID string
+	}{
+		{linter: "staticcheck", ruleID: "SA4011"},
+		{linter: "staticcheck", ruleID: "SA1019"},
+		{linter: "staticcheck", ruleID: "SA4010"},
+		{linter: "staticcheck"},
+		{linter: "gosec", ruleID: "SA4011"},
+	}
+
+	var issues []result.Issue
+	for _, c := range cases {
+		issues = append(issues, result.Issue{
+			FromLinter: c.linter,
+			RuleID:     c.ruleID,
+		})
+	}
+
+	processedIssues := process(t, p, issues...)
+
+	assert.Equal(t, issues[2:], processedIssues)
+}
+
 func TestExcludeRules_empty(t *testing.T) {
 	processAssertSame(t, NewExcludeRules(nil, nil, &config.Issues{}), newIssueFromTextTestCase("test"))
 }
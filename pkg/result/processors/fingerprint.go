@@ -0,0 +1,175 @@
+package processors
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strings"
+
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+var _ Processor = (*Fingerprint)(nil)
+
+type declaration struct {
+	name     string
+	from, to token.Position
+}
+
+func (d declaration) contains(issue *result.Issue) bool {
+	return comparePosition(d.from, issue.Line(), issue.Column()) <= 0 &&
+		comparePosition(d.to, issue.Line(), issue.Column()) >= 0
+}
+
+// Fingerprint sets the information used by the fingerprints of the issues:
+// the declaration containing the issue and the occurrence index of the issue.
+type Fingerprint struct {
+	declarations map[string][]declaration
+}
+
+func NewFingerprint() *Fingerprint {
+	return &Fingerprint{
+		declarations: map[string][]declaration{},
+	}
+}
+
+func (*Fingerprint) Name() string {
+	return "fingerprint"
+}
+
+func (p *Fingerprint) Process(issues []result.Issue) ([]result.Issue, error) {
+	issues = transformIssues(issues, func(issue *result.Issue) *result.Issue {
+		newIssue := *issue
+		newIssue.Symbol = p.getSymbol(issue)
+		newIssue.Occurrence = 0
+
+		return &newIssue
+	})
+
+	// The occurrence index is based on the position of the issues.
+	positions := make([]int, len(issues))
+	for i := range positions {
+		positions[i] = i
+	}
+
+	sort.SliceStable(positions, func(a, b int) bool {
+		ia, ib := &issues[positions[a]], &issues[positions[b]]
+		if ia.FilePath() != ib.FilePath() {
+			return ia.FilePath() < ib.FilePath()
+		}
+		if ia.Line() != ib.Line() {
+			return ia.Line() < ib.Line()
+		}
+		return ia.Column() < ib.Column()
+	})
+
+	occurrences := map[string]int{}
+	for _, i := range positions {
+		issue := &issues[i]
+
+		fingerprint := issue.Fingerprint()
+		issue.Occurrence = occurrences[fingerprint]
+		occurrences[fingerprint]++
+	}
+
+	return issues, nil
+}
+
+func (*Fingerprint) Finish() {}
+
+func (p *Fingerprint) getSymbol(issue *result.Issue) string {
+	declarations, ok := p.declarations[issue.FilePath()]
+	if !ok {
+		declarations = parseDeclarations(issue.FilePath())
+		p.declarations[issue.FilePath()] = declarations
+	}
+
+	for _, decl := range declarations {
+		if decl.contains(issue) {
+			return decl.name
+		}
+	}
+
+	return ""
+}
+
+// parseDeclarations returns the top-level declarations of the file.
+func parseDeclarations(filePath string) []declaration {
+	// Don't use cached AST because they consume a lot of memory on large projects.
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filePath, nil, parser.SkipObjectResolution)
+	if err != nil {
+		// Don't report error because it's already must be reporter by typecheck or go/analysis.
+		return nil
+	}
+
+	newDeclaration := func(name string, node ast.Node) declaration {
+		return declaration{name: name, from: fset.Position(node.Pos()), to: fset.Position(node.End())}
+	}
+
+	var declarations []declaration
+	for _, decl := range f.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			name := d.Name.Name
+			if d.Recv != nil && len(d.Recv.List) > 0 {
+				name = receiverTypeName(d.Recv.List[0].Type) + "." + name
+			}
+
+			declarations = append(declarations, newDeclaration(name, d))
+
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				var node ast.Node = spec
+				if len(d.Specs) == 1 {
+					node = d // includes the keyword and the doc
+				}
+
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					declarations = append(declarations, newDeclaration(s.Name.Name, node))
+				case *ast.ValueSpec:
+					names := make([]string, 0, len(s.Names))
+					for _, n := range s.Names {
+						names = append(names, n.Name)
+					}
+
+					declarations = append(declarations, newDeclaration(strings.Join(names, ","), node))
+				}
+			}
+		}
+	}
+
+	return declarations
+}
+
+func receiverTypeName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		return receiverTypeName(e.X)
+	case *ast.IndexExpr:
+		return receiverTypeName(e.X)
+	case *ast.IndexListExpr:
+		return receiverTypeName(e.X)
+	case *ast.ParenExpr:
+		return receiverTypeName(e.X)
+	case *ast.Ident:
+		return e.Name
+	default:
+		return ""
+	}
+}
+
+// comparePosition compares a position with a line and a column.
+// A column equals to 0 means the whole line.
+func comparePosition(pos token.Position, line, column int) int {
+	switch {
+	case pos.Line != line:
+		return pos.Line - line
+	case column == 0:
+		return 0
+	default:
+		return pos.Column - column
+	}
+}
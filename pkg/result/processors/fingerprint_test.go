@@ -0,0 +1,57 @@
+package processors
+
+import (
+	"go/token"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+func newFingerprintIssue(line, column int, source string) result.Issue {
+	return result.Issue{
+		FromLinter:  "linter",
+		Text:        "text",
+		Pos:         token.Position{Filename: filepath.FromSlash("testdata/fingerprint.go"), Line: line, Column: column},
+		SourceLines: []string{source},
+	}
+}
+
+func TestFingerprint_symbol(t *testing.T) {
+	issues := process(t, NewFingerprint(),
+		newFingerprintIssue(4, 2, "Bar string"),
+		newFingerprintIssue(8, 6, "_ = f.Bar"),
+		newFingerprintIssue(14, 0, "b = 2"),
+		newFingerprintIssue(17, 1, "func Func() {}"),
+		newFingerprintIssue(1, 1, "package testdata"),
+	)
+
+	var symbols []string
+	for _, issue := range issues {
+		symbols = append(symbols, issue.Symbol)
+	}
+
+	assert.Equal(t, []string{"Foo", "Foo.Method", "b", "Func", ""}, symbols)
+}
+
+func TestFingerprint_occurrence(t *testing.T) {
+	issues := process(t, NewFingerprint(),
+		newFingerprintIssue(9, 2, "\t_ = f.Bar"),
+		newFingerprintIssue(8, 2, "_ = f.Bar"),
+	)
+
+	require.Len(t, issues, 2)
+
+	assert.Equal(t, 1, issues[0].Occurrence)
+	assert.Equal(t, 0, issues[1].Occurrence)
+	assert.NotEqual(t, issues[0].Fingerprint(), issues[1].Fingerprint())
+
+	// The fingerprint doesn't depend on the position.
+	shifted := process(t, NewFingerprint(), newFingerprintIssue(8, 2, "_ = f.Bar"))
+	shifted[0].Pos.Line += 10
+
+	assert.Equal(t, issues[1].Fingerprint(), shifted[0].Fingerprint())
+}
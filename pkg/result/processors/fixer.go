@@ -1,13 +1,22 @@
 package processors
 
 import (
+	"bufio"
 	"bytes"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+	"golang.org/x/exp/maps"
+
 	"github.com/snowmerak/golangci-lint/internal/robustio"
 	"github.com/snowmerak/golangci-lint/pkg/config"
 	"github.com/snowmerak/golangci-lint/pkg/fsutils"
@@ -23,6 +32,10 @@ type Fixer struct {
 	log       logutils.Log
 	fileCache *fsutils.FileCache
 	sw        *timeutils.Stopwatch
+
+	// used by the diff and interactive modes
+	out io.Writer
+	in  *bufio.Reader
 }
 
 func NewFixer(cfg *config.Config, log logutils.Log, fileCache *fsutils.FileCache) *Fixer {
@@ -31,6 +44,8 @@ func NewFixer(cfg *config.Config, log logutils.Log, fileCache *fsutils.FileCache
 		log:       log,
 		fileCache: fileCache,
 		sw:        timeutils.NewStopwatch("fixer", log),
+		out:       logutils.StdOut,
+		in:        bufio.NewReader(os.Stdin),
 	}
 }
 
@@ -39,10 +54,14 @@ func (Fixer) Name() string {
 }
 
 func (p Fixer) Process(issues []result.Issue) ([]result.Issue, error) {
-	if !p.cfg.Issues.NeedFix {
+	if !p.cfg.Issues.NeedFix && p.cfg.Issues.AddNolint == "" {
 		return issues, nil
 	}
 
+	if p.cfg.Issues.AddNolint != "" {
+		p.addNolintReplacements(issues)
+	}
+
 	outIssues := make([]result.Issue, 0, len(issues))
 	issuesToFixPerFile := map[string][]result.Issue{}
 	for i := range issues {
@@ -55,17 +74,39 @@ func (p Fixer) Process(issues []result.Issue) ([]result.Issue, error) {
 		issuesToFixPerFile[issue.FilePath()] = append(issuesToFixPerFile[issue.FilePath()], *issue)
 	}
 
-	for file, issuesToFix := range issuesToFixPerFile {
+	files := maps.Keys(issuesToFixPerFile)
+	sort.Strings(files)
+
+	var quit bool
+	for _, file := range files {
+		issuesToFix := issuesToFixPerFile[file]
+
+		if quit {
+			outIssues = append(outIssues, issuesToFix...)
+			continue
+		}
+
+		var notFixedIssues []result.Issue
 		var err error
 		p.sw.TrackStage("all", func() {
-			err = p.fixIssuesInFile(file, issuesToFix)
+			switch p.cfg.Issues.FixMode {
+			case config.FixModeDiff:
+				notFixedIssues, err = p.printFixesDiff(file, issuesToFix)
+			case config.FixModeInteractive:
+				notFixedIssues, quit, err = p.reviewFixes(file, issuesToFix)
+			default:
+				notFixedIssues, err = p.fixIssuesInFile(file, issuesToFix)
+			}
 		})
 		if err != nil {
 			p.log.Errorf("Failed to fix issues in file %s: %s", file, err)
 
 			// show issues only if can't fix them
 			outIssues = append(outIssues, issuesToFix...)
+			continue
 		}
+
+		outIssues = append(outIssues, notFixedIssues...)
 	}
 
 	p.printStat()
@@ -75,43 +116,169 @@ func (p Fixer) Process(issues []result.Issue) ([]result.Issue, error) {
 
 func (Fixer) Finish() {}
 
-func (p Fixer) fixIssuesInFile(filePath string, issues []result.Issue) error {
-	// TODO: don't read the whole file into memory: read line by line;
-	// can't just use bufio.scanner: it has a line length limit
+// fixIssuesInFile applies the replacements of the issues to the file and returns the issues that weren't fixed.
+func (p Fixer) fixIssuesInFile(filePath string, issues []result.Issue) ([]result.Issue, error) {
 	origFileData, err := p.fileCache.GetFileBytes(filePath)
 	if err != nil {
-		return fmt.Errorf("failed to get file bytes for %s: %w", filePath, err)
+		return nil, fmt.Errorf("failed to get file bytes for %s: %w", filePath, err)
 	}
 
-	origFileLines := bytes.Split(origFileData, []byte("\n"))
+	fixedFileData, notFixedIssues := p.applyFixes(origFileData, issues)
+	if len(notFixedIssues) == len(issues) {
+		return notFixedIssues, nil
+	}
 
-	tmpFileName := filepath.Join(filepath.Dir(filePath), fmt.Sprintf(".%s.golangci_fix", filepath.Base(filePath)))
+	if err = writeFixedFile(filePath, fixedFileData); err != nil {
+		return nil, err
+	}
 
-	tmpOutFile, err := os.Create(tmpFileName)
+	return notFixedIssues, nil
+}
+
+// printFixesDiff prints the unified diff of the fixes without modifying the file.
+// All the issues are returned because nothing is fixed.
+func (p Fixer) printFixesDiff(filePath string, issues []result.Issue) ([]result.Issue, error) {
+	origFileData, err := p.fileCache.GetFileBytes(filePath)
 	if err != nil {
-		return fmt.Errorf("failed to make file %s: %w", tmpFileName, err)
+		return nil, fmt.Errorf("failed to get file bytes for %s: %w", filePath, err)
 	}
 
-	// merge multiple issues per line into one issue
-	issuesPerLine := map[int][]result.Issue{}
+	fixedFileData, _ := p.applyFixes(origFileData, issues)
+
+	if err = p.printDiff(filePath, origFileData, fixedFileData); err != nil {
+		return nil, err
+	}
+
+	return issues, nil
+}
+
+// reviewFixes asks for each fix if it must be applied, then applies the accepted fixes to the file.
+// The returned boolean is true if the user asked to stop the review.
+func (p Fixer) reviewFixes(filePath string, issues []result.Issue) ([]result.Issue, bool, error) {
+	origFileData, err := p.fileCache.GetFileBytes(filePath)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get file bytes for %s: %w", filePath, err)
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Line() != issues[j].Line() {
+			return issues[i].Line() < issues[j].Line()
+		}
+		return issues[i].Column() < issues[j].Column()
+	})
+
+	var quit bool
+	var acceptedIssues, notFixedIssues []result.Issue
+
 	for i := range issues {
 		issue := &issues[i]
-		issuesPerLine[issue.Line()] = append(issuesPerLine[issue.Line()], *issue)
+
+		fixedFileData, invalidIssues := p.applyFixes(origFileData, issues[i:i+1])
+		if len(invalidIssues) != 0 {
+			notFixedIssues = append(notFixedIssues, *issue)
+			continue
+		}
+
+		_, _ = fmt.Fprintf(p.out, "%s:%d:%d: %s\n", issue.FilePath(), issue.Line(), issue.Column(), issue.Description())
+
+		if err = p.printDiff(filePath, origFileData, fixedFileData); err != nil {
+			return nil, false, err
+		}
+
+		answer, err := p.ask("Apply this fix? [y]es, [n]o, [q]uit: ")
+		if err != nil {
+			return nil, false, err
+		}
+
+		if answer == "q" {
+			quit = true
+			notFixedIssues = append(notFixedIssues, issues[i:]...)
+			break
+		}
+
+		if answer == "y" {
+			acceptedIssues = append(acceptedIssues, *issue)
+		} else {
+			notFixedIssues = append(notFixedIssues, *issue)
+		}
+	}
+
+	if len(acceptedIssues) == 0 {
+		return notFixedIssues, quit, nil
+	}
+
+	// The accepted fixes can conflict with each other.
+	acceptedEdits, conflictingIssues := p.mergeFixes(origFileData, acceptedIssues)
+
+	if err = writeFixedFile(filePath, result.ApplyTextEdits(origFileData, acceptedEdits)); err != nil {
+		return nil, false, err
 	}
 
-	issues = issues[:0] // reuse the same memory
-	for line, lineIssues := range issuesPerLine {
-		if mergedIssue := p.mergeLineIssues(line, lineIssues, origFileLines); mergedIssue != nil {
-			issues = append(issues, *mergedIssue)
+	// The declined issues can be fixed by the accepted fixes (ex: several issues require the same import).
+	lineStarts := getLineStarts(origFileData)
+
+	notFixedIssues = filterIssues(notFixedIssues, func(issue *result.Issue) bool {
+		edits, err := toTextEdits(issue, origFileData, lineStarts)
+
+		return err != nil || !isFixedByEdits(origFileData, acceptedEdits, edits)
+	})
+
+	return append(notFixedIssues, conflictingIssues...), quit, nil
+}
+
+// ask prompts until the answer is `y`, `n` or `q`.
+// The end of the input is handled as `q`.
+func (p Fixer) ask(prompt string) (string, error) {
+	for {
+		_, _ = fmt.Fprint(p.out, prompt)
+
+		line, err := p.in.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return "", fmt.Errorf("failed to read answer: %w", err)
+		}
+
+		answer := strings.ToLower(strings.TrimSpace(line))
+		if answer != "" {
+			answer = answer[:1]
+		}
+
+		switch {
+		case answer == "y", answer == "n", answer == "q":
+			return answer, nil
+		case errors.Is(err, io.EOF):
+			_, _ = fmt.Fprintln(p.out)
+			return "q", nil
 		}
 	}
+}
+
+func (p Fixer) printDiff(filePath string, origFileData, fixedFileData []byte) error {
+	if bytes.Equal(origFileData, fixedFileData) {
+		return nil
+	}
+
+	edits := myers.ComputeEdits(span.URIFromPath(filePath), string(origFileData), string(fixedFileData))
+	unified := gotextdiff.ToUnified("a/"+filePath, "b/"+filePath, string(origFileData), edits)
 
-	issues = p.findNotIntersectingIssues(issues)
+	if _, err := fmt.Fprint(p.out, unified); err != nil {
+		return fmt.Errorf("failed to print diff: %w", err)
+	}
+
+	return nil
+}
+
+func writeFixedFile(filePath string, data []byte) error {
+	tmpFileName := filepath.Join(filepath.Dir(filePath), fmt.Sprintf(".%s.golangci_fix", filepath.Base(filePath)))
 
-	if err = p.writeFixedFile(origFileLines, issues, tmpOutFile); err != nil {
+	tmpOutFile, err := os.Create(tmpFileName)
+	if err != nil {
+		return fmt.Errorf("failed to make file %s: %w", tmpFileName, err)
+	}
+
+	if _, err = tmpOutFile.Write(data); err != nil {
 		tmpOutFile.Close()
 		_ = robustio.RemoveAll(tmpOutFile.Name())
-		return err
+		return fmt.Errorf("failed to write fixed file: %w", err)
 	}
 
 	tmpOutFile.Close()
@@ -124,138 +291,268 @@ func (p Fixer) fixIssuesInFile(filePath string, issues []result.Issue) error {
 	return nil
 }
 
-func (p Fixer) mergeLineIssues(lineNum int, lineIssues []result.Issue, origFileLines [][]byte) *result.Issue {
-	origLine := origFileLines[lineNum-1] // lineNum is 1-based
+// applyFixes merges the text edits of the issues and applies them to the file content.
+// The issues with invalid edits are returned as not fixed.
+// The issues with edits conflicting with the edits of a previous issue are also returned as not fixed,
+// unless the accepted edits produce the same text as their edits.
+func (p Fixer) applyFixes(origFileData []byte, issues []result.Issue) ([]byte, []result.Issue) {
+	acceptedEdits, notFixedIssues := p.mergeFixes(origFileData, issues)
 
-	if len(lineIssues) == 1 && lineIssues[0].Replacement.Inline == nil {
-		return &lineIssues[0]
+	return result.ApplyTextEdits(origFileData, acceptedEdits), notFixedIssues
+}
+
+// mergeFixes returns the merged text edits of the issues, and the issues that aren't fixed by them (see applyFixes).
+func (p Fixer) mergeFixes(origFileData []byte, issues []result.Issue) ([]result.TextEdit, []result.Issue) {
+	lineStarts := getLineStarts(origFileData)
+
+	type issueEdits struct {
+		issue *result.Issue
+		edits []result.TextEdit
 	}
 
-	// check issues first
-	for ind := range lineIssues {
-		li := &lineIssues[ind]
+	var notFixedIssues []result.Issue
+
+	candidates := make([]issueEdits, 0, len(issues))
+	for i := range issues {
+		issue := &issues[i]
 
-		if li.LineRange != nil {
-			p.log.Infof("Line %d has multiple issues but at least one of them is ranged: %#v", lineNum, lineIssues)
-			return &lineIssues[0]
+		edits, err := toTextEdits(issue, origFileData, lineStarts)
+		if err != nil {
+			p.log.Warnf("[fixer]: can't fix issue from %s at %s: %v", issue.FromLinter, issue.Pos, err)
+			notFixedIssues = append(notFixedIssues, *issue)
+			continue
 		}
 
-		inline := li.Replacement.Inline
+		candidates = append(candidates, issueEdits{issue: issue, edits: edits})
+	}
 
-		if inline == nil || len(li.Replacement.NewLines) != 0 || li.Replacement.NeedOnlyDelete {
-			p.log.Infof("Line %d has multiple issues but at least one of them isn't inline: %#v", lineNum, lineIssues)
-			return li
-		}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].edits[0].Pos < candidates[j].edits[0].Pos
+	})
 
-		if inline.StartCol < 0 || inline.Length <= 0 || inline.StartCol+inline.Length > len(origLine) {
-			p.log.Warnf("Line %d (%q) has invalid inline fix: %#v, %#v", lineNum, origLine, li, inline)
-			return nil
+	var acceptedEdits []result.TextEdit
+	for _, candidate := range candidates {
+		if conflictingEdit := findConflictingEdit(acceptedEdits, candidate.edits); conflictingEdit != nil {
+			// The fixes of several linters can rewrite the same code differently (ex: gofmt and goimports):
+			// the code of the issue is replaced by the accepted edits.
+			if isFixedByEdits(origFileData, acceptedEdits, candidate.edits) || isCoveredByEdits(acceptedEdits, candidate.edits) {
+				p.log.Infof("Issue %#v is fixed by the conflicting edit %#v", candidate.issue, conflictingEdit)
+				continue
+			}
+
+			p.log.Infof("Skip issue %#v: conflicts with the edit %#v", candidate.issue, conflictingEdit)
+			notFixedIssues = append(notFixedIssues, *candidate.issue)
+			continue
 		}
+
+		p.log.Infof("Fix issue %#v with edits %#v", candidate.issue, candidate.edits)
+		acceptedEdits = mergeEdits(acceptedEdits, candidate.edits)
 	}
 
-	return p.applyInlineFixes(lineIssues, origLine, lineNum)
+	return acceptedEdits, notFixedIssues
 }
 
-func (p Fixer) applyInlineFixes(lineIssues []result.Issue, origLine []byte, lineNum int) *result.Issue {
-	sort.Slice(lineIssues, func(i, j int) bool {
-		return lineIssues[i].Replacement.Inline.StartCol < lineIssues[j].Replacement.Inline.StartCol
-	})
+func (p Fixer) printStat() {
+	p.sw.PrintStages()
+}
+
+// ReplacementTextEdits converts the replacement of the issue to sorted text edits on the file content.
+func ReplacementTextEdits(issue *result.Issue, fileData []byte) ([]result.TextEdit, error) {
+	return toTextEdits(issue, fileData, getLineStarts(fileData))
+}
 
-	var newLineBuf bytes.Buffer
-	newLineBuf.Grow(len(origLine))
+// toTextEdits converts the replacement of the issue to sorted text edits.
+// The whole-line and inline replacements are expressed through the line starts of the file.
+func toTextEdits(issue *result.Issue, fileData []byte, lineStarts []int) ([]result.TextEdit, error) {
+	r := issue.Replacement
 
-	//nolint:misspell // misspelling is intentional
-	// example: origLine="it's becouse of them", StartCol=5, Length=7, NewString="because"
+	switch {
+	case len(r.TextEdits) != 0:
+		edits := make([]result.TextEdit, len(r.TextEdits))
+		copy(edits, r.TextEdits)
 
-	curOrigLinePos := 0
-	for i := range lineIssues {
-		fix := lineIssues[i].Replacement.Inline
-		if fix.StartCol < curOrigLinePos {
-			p.log.Warnf("Line %d has multiple intersecting issues: %#v", lineNum, lineIssues)
-			return nil
+		sortTextEdits(edits)
+
+		for i, edit := range edits {
+			if edit.Pos < 0 || edit.Pos > edit.End || edit.End > len(fileData) {
+				return nil, fmt.Errorf("invalid edit [%d, %d) for file of size %d", edit.Pos, edit.End, len(fileData))
+			}
+
+			if i > 0 && edit.Pos < edits[i-1].End {
+				return nil, fmt.Errorf("overlapping edits [%d, %d) and [%d, %d)",
+					edits[i-1].Pos, edits[i-1].End, edit.Pos, edit.End)
+			}
 		}
 
-		if curOrigLinePos != fix.StartCol {
-			newLineBuf.Write(origLine[curOrigLinePos:fix.StartCol])
+		return edits, nil
+
+	case r.Inline != nil:
+		line := issue.Line()
+		if line < 1 || line > len(lineStarts) {
+			return nil, fmt.Errorf("invalid line %d", line)
 		}
-		newLineBuf.WriteString(fix.NewString)
-		curOrigLinePos = fix.StartCol + fix.Length
-	}
-	if curOrigLinePos != len(origLine) {
-		newLineBuf.Write(origLine[curOrigLinePos:])
-	}
 
-	mergedIssue := lineIssues[0] // use text from the first issue (it's not really used)
-	mergedIssue.Replacement = &result.Replacement{
-		NewLines: []string{newLineBuf.String()},
+		start, end := lineStarts[line-1], getLineEnd(fileData, lineStarts, line)
+
+		pos := start + r.Inline.StartCol
+		if r.Inline.StartCol < 0 || r.Inline.Length < 0 || pos+r.Inline.Length > end {
+			return nil, fmt.Errorf("invalid inline fix for line %d (%q): %#v", line, fileData[start:end], r.Inline)
+		}
+
+		return []result.TextEdit{{Pos: pos, End: pos + r.Inline.Length, NewText: r.Inline.NewString}}, nil
+
+	default:
+		rng := issue.GetLineRange()
+		if rng.From < 1 || rng.From > rng.To || rng.To > len(lineStarts) {
+			return nil, fmt.Errorf("invalid line range (from=%d, to=%d)", rng.From, rng.To)
+		}
+
+		if r.NeedOnlyDelete {
+			// delete the lines with their line breaks
+			end := len(fileData)
+			if rng.To < len(lineStarts) {
+				end = lineStarts[rng.To]
+			}
+
+			return []result.TextEdit{{Pos: lineStarts[rng.From-1], End: end}}, nil
+		}
+
+		return []result.TextEdit{{
+			Pos:     lineStarts[rng.From-1],
+			End:     getLineEnd(fileData, lineStarts, rng.To),
+			NewText: strings.Join(r.NewLines, "\n"),
+		}}, nil
 	}
-	return &mergedIssue
 }
 
-func (p Fixer) findNotIntersectingIssues(issues []result.Issue) []result.Issue {
-	sort.SliceStable(issues, func(i, j int) bool {
-		a, b := issues[i], issues[j]
-		return a.Line() < b.Line()
-	})
+// findConflictingEdit returns the first accepted edit that intersects one of the edits.
+// Identical edits aren't conflicting: e.g. two issues can require the same import.
+func findConflictingEdit(accepted, edits []result.TextEdit) *result.TextEdit {
+	for _, edit := range edits {
+		for i := range accepted {
+			a := &accepted[i]
 
-	var ret []result.Issue
-	var currentEnd int
-	for i := range issues {
-		issue := &issues[i]
-		rng := issue.GetLineRange()
-		if rng.From <= currentEnd {
-			p.log.Infof("Skip issue %#v: intersects with end %d", issue, currentEnd)
-			continue // skip intersecting issue
+			if *a == edit {
+				continue
+			}
+
+			if edit.Pos < a.End && a.Pos < edit.End {
+				return a
+			}
+
+			// the order of two insertions at the same position is ambiguous
+			if edit.Pos == edit.End && a.Pos == a.End && edit.Pos == a.Pos {
+				return a
+			}
 		}
-		p.log.Infof("Fix issue %#v with range %v", issue, issue.GetLineRange())
-		ret = append(ret, *issue)
-		currentEnd = rng.To
 	}
 
-	return ret
+	return nil
 }
 
-func (p Fixer) writeFixedFile(origFileLines [][]byte, issues []result.Issue, tmpOutFile *os.File) error {
-	// issues aren't intersecting
+// isFixedByEdits returns true if the accepted edits produce the same text as the conflicting edits
+// on the part of the file covered by both.
+func isFixedByEdits(data []byte, accepted, edits []result.TextEdit) bool {
+	start, end := edits[0].Pos, edits[len(edits)-1].End
+
+	// The part is extended until it contains all the edits intersecting it.
+	for changed := true; changed; {
+		changed = false
 
-	nextIssueIndex := 0
-	for i := 0; i < len(origFileLines); i++ {
-		var outLine string
-		var nextIssue *result.Issue
-		if nextIssueIndex != len(issues) {
-			nextIssue = &issues[nextIssueIndex]
+		for _, edit := range slices.Concat(accepted, edits) {
+			if edit.Pos > end || edit.End < start {
+				continue
+			}
+
+			if edit.Pos < start || edit.End > end {
+				start, end = min(start, edit.Pos), max(end, edit.End)
+				changed = true
+			}
 		}
+	}
 
-		origFileLineNumber := i + 1
-		if nextIssue == nil || origFileLineNumber != nextIssue.GetLineRange().From {
-			outLine = string(origFileLines[i])
-		} else {
-			nextIssueIndex++
-			rng := nextIssue.GetLineRange()
-			if rng.From > rng.To {
-				// Maybe better decision is to skip such issues, re-evaluate if regressed.
-				p.log.Warnf("[fixer]: issue line range is probably invalid, fix can be incorrect (from=%d, to=%d, linter=%s)",
-					rng.From, rng.To, nextIssue.FromLinter,
-				)
+	part := func(all []result.TextEdit) []byte {
+		var inside []result.TextEdit
+		for _, edit := range all {
+			if edit.Pos >= start && edit.End <= end {
+				inside = append(inside, result.TextEdit{Pos: edit.Pos - start, End: edit.End - start, NewText: edit.NewText})
 			}
-			i += rng.To - rng.From
-			if nextIssue.Replacement.NeedOnlyDelete {
-				continue
+		}
+
+		return result.ApplyTextEdits(data[start:end], inside)
+	}
+
+	return bytes.Equal(part(accepted), part(edits))
+}
+
+// isCoveredByEdits returns true if the sorted accepted edits replace all the text replaced by the edits.
+// An insertion is covered if it's strictly inside the text replaced by an accepted edit.
+func isCoveredByEdits(accepted, edits []result.TextEdit) bool {
+	for _, edit := range edits {
+		if edit.Pos == edit.End {
+			if !slices.ContainsFunc(accepted, func(a result.TextEdit) bool { return a.Pos < edit.Pos && edit.Pos < a.End }) {
+				return false
+			}
+
+			continue
+		}
+
+		pos := edit.Pos
+		for _, a := range accepted {
+			if a.Pos <= pos && pos < a.End {
+				pos = a.End
 			}
-			outLine = strings.Join(nextIssue.Replacement.NewLines, "\n")
 		}
 
-		if i < len(origFileLines)-1 {
-			outLine += "\n"
+		if pos < edit.End {
+			return false
 		}
-		if _, err := tmpOutFile.WriteString(outLine); err != nil {
-			return fmt.Errorf("failed to write output line: %w", err)
+	}
+
+	return true
+}
+
+// mergeEdits adds the edits to the accepted ones, without duplicates.
+func mergeEdits(accepted, edits []result.TextEdit) []result.TextEdit {
+	for _, edit := range edits {
+		if !slices.Contains(accepted, edit) {
+			accepted = append(accepted, edit)
 		}
 	}
 
-	return nil
+	sortTextEdits(accepted)
+
+	return accepted
 }
 
-func (p Fixer) printStat() {
-	p.sw.PrintStages()
+// sortTextEdits sorts edits by position.
+// An insertion is placed before a replacement starting at the same position.
+func sortTextEdits(edits []result.TextEdit) {
+	sort.SliceStable(edits, func(i, j int) bool {
+		if edits[i].Pos != edits[j].Pos {
+			return edits[i].Pos < edits[j].Pos
+		}
+		return edits[i].End < edits[j].End
+	})
+}
+
+// getLineStarts returns the offsets of the beginning of each line.
+func getLineStarts(data []byte) []int {
+	lineStarts := []int{0}
+	for i, b := range data {
+		if b == '\n' {
+			lineStarts = append(lineStarts, i+1)
+		}
+	}
+
+	return lineStarts
+}
+
+// getLineEnd returns the offset of the end of the line (1-based), without the line break.
+func getLineEnd(data []byte, lineStarts []int, line int) int {
+	if line < len(lineStarts) {
+		return lineStarts[line] - 1
+	}
+
+	return len(data)
 }
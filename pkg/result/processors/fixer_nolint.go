@@ -0,0 +1,395 @@
+package processors
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+const (
+	// A directive is placed above a declaration when a linter reports issues on at least this number of its lines.
+	nolintDeclarationThreshold = 3
+	// A directive is placed above the package clause when a linter reports issues in at least this number of declarations.
+	nolintFileThreshold = 3
+)
+
+var (
+	// nolintCommentPattern matches the comments handled as nolint directives by the Nolint processor.
+	nolintCommentPattern = regexp.MustCompile(`^//[/ ]*nolint( |:|$)`)
+	// nolintLintersPattern matches the linters of a nolint directive, to extend them.
+	nolintLintersPattern = regexp.MustCompile(`^//[/ ]*nolint:\s*([\w-]+(?:\s*,\s*[\w-]+)*)`)
+)
+
+// addNolintReplacements replaces the fixes of the issues by the addition of nolint directives (`issues.add-nolint`).
+// The issues fixed by their linters (`issues.fix`) keep their fixes.
+// The issues without position inside a Go file can't be suppressed: they lose their fixes.
+func (p Fixer) addNolintReplacements(issues []result.Issue) {
+	issuesPerFile := map[string][]*result.Issue{}
+
+	for i := range issues {
+		issue := &issues[i]
+
+		if p.cfg.Issues.NeedFix && issue.Replacement != nil {
+			continue
+		}
+
+		issue.Replacement = nil
+
+		if issue.FromLinter == typeCheckName || issue.Line() < 1 || !strings.HasSuffix(issue.FilePath(), ".go") {
+			continue
+		}
+
+		issuesPerFile[issue.FilePath()] = append(issuesPerFile[issue.FilePath()], issue)
+	}
+
+	for filePath, fileIssues := range issuesPerFile {
+		fileData, err := p.fileCache.GetFileBytes(filePath)
+		if err != nil {
+			p.log.Warnf("Failed to add nolint directives to %s: %s", filePath, err)
+			continue
+		}
+
+		ins, err := newNolintInserter(filePath, fileData, p.cfg.Issues.AddNolint)
+		if err != nil {
+			p.log.Warnf("Failed to add nolint directives to %s: %s", filePath, err)
+			continue
+		}
+
+		ins.insert(fileIssues)
+	}
+}
+
+// nolintPlacement is a nolint directive to add or to extend with the linters of the issues.
+type nolintPlacement struct {
+	// The offset of the insertion.
+	pos int
+	// The texts around the inserted linters.
+	prefix, suffix string
+	// The linters of the extended directive.
+	existing []string
+
+	linters map[string]bool
+	issues  []*result.Issue
+}
+
+func (pl *nolintPlacement) edit() (result.TextEdit, bool) {
+	var linters []string
+
+	for linter := range pl.linters {
+		if !slices.Contains(pl.existing, linter) {
+			linters = append(linters, linter)
+		}
+	}
+
+	if len(linters) == 0 {
+		return result.TextEdit{}, false
+	}
+
+	sort.Strings(linters)
+
+	return result.TextEdit{
+		Pos:     pl.pos,
+		End:     pl.pos,
+		NewText: pl.prefix + strings.Join(linters, ",") + pl.suffix,
+	}, true
+}
+
+// nolintInserter computes the placements of the nolint directives of the issues of a file.
+type nolintInserter struct {
+	fset *token.FileSet
+	file *ast.File
+
+	data       []byte
+	lineStarts []int
+
+	reason string
+
+	// The lines ending inside a token: a multi-line string or comment.
+	continuedLines map[int]bool
+
+	placements map[int]*nolintPlacement
+}
+
+func newNolintInserter(filePath string, data []byte, reason string) (*nolintInserter, error) {
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, filePath, data, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse: %w", err)
+	}
+
+	ins := &nolintInserter{
+		fset:           fset,
+		file:           file,
+		data:           data,
+		lineStarts:     getLineStarts(data),
+		reason:         reason,
+		continuedLines: map[int]bool{},
+		placements:     map[int]*nolintPlacement{},
+	}
+
+	markContinued := func(node ast.Node) {
+		for line := ins.line(node.Pos()); line < ins.line(node.End()); line++ {
+			ins.continuedLines[line] = true
+		}
+	}
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		if lit, ok := node.(*ast.BasicLit); ok {
+			markContinued(lit)
+		}
+
+		return true
+	})
+
+	for _, group := range file.Comments {
+		for _, c := range group.List {
+			markContinued(c)
+		}
+	}
+
+	return ins, nil
+}
+
+// insert sets the replacements adding the directives to the issues.
+// The linters reporting issues in many declarations are disabled for the whole file,
+// the linters reporting issues on many lines of a declaration are disabled for the declaration.
+// The other issues are suppressed at their lines, or above their statements.
+func (ins *nolintInserter) insert(issues []*result.Issue) {
+	type declLinter struct {
+		decl   ast.Decl
+		linter string
+	}
+
+	declLines := map[declLinter]map[int]bool{}
+	linterDecls := map[string]map[ast.Decl]bool{}
+
+	for _, issue := range issues {
+		decl := ins.declaration(issue.Line())
+		if decl == nil {
+			continue
+		}
+
+		key := declLinter{decl: decl, linter: issue.FromLinter}
+		if declLines[key] == nil {
+			declLines[key] = map[int]bool{}
+		}
+
+		declLines[key][issue.Line()] = true
+
+		if linterDecls[issue.FromLinter] == nil {
+			linterDecls[issue.FromLinter] = map[ast.Decl]bool{}
+		}
+
+		linterDecls[issue.FromLinter][decl] = true
+	}
+
+	for _, issue := range issues {
+		line := issue.Line()
+		decl := ins.declaration(line)
+
+		var pl *nolintPlacement
+
+		switch {
+		case len(linterDecls[issue.FromLinter]) >= nolintFileThreshold && line >= ins.line(ins.file.Package):
+			pl = ins.abovePlacement(ins.file)
+		case decl != nil && len(declLines[declLinter{decl: decl, linter: issue.FromLinter}]) >= nolintDeclarationThreshold:
+			pl = ins.abovePlacement(decl)
+		default:
+			pl = ins.inlinePlacement(line)
+			if pl == nil {
+				pl = ins.abovePlacement(ins.enclosingNode(line))
+			}
+		}
+
+		if pl == nil {
+			continue
+		}
+
+		pl.linters[issue.FromLinter] = true
+		pl.issues = append(pl.issues, issue)
+	}
+
+	for _, pl := range ins.placements {
+		edit, ok := pl.edit()
+		if !ok {
+			continue
+		}
+
+		// The issues of a directive share the same edit: the identical edits are merged by the fixer.
+		for _, issue := range pl.issues {
+			issue.Replacement = &result.Replacement{TextEdits: []result.TextEdit{edit}}
+		}
+	}
+}
+
+// inlinePlacement returns the placement at the end of the line, or nil if the line can't have a directive:
+// a directive can't be added after a comment or inside a multi-line token.
+func (ins *nolintInserter) inlinePlacement(line int) *nolintPlacement {
+	if line > len(ins.lineStarts) || ins.continuedLines[line] {
+		return nil
+	}
+
+	for _, group := range ins.file.Comments {
+		for _, c := range group.List {
+			if ins.line(c.End()) != line {
+				continue
+			}
+
+			if ins.line(c.Pos()) == line && nolintCommentPattern.MatchString(c.Text) {
+				return ins.existingPlacement(c)
+			}
+
+			return nil
+		}
+	}
+
+	end := getLineEnd(ins.data, ins.lineStarts, line)
+	if end > ins.lineStarts[line-1] && ins.data[end-1] == '\r' {
+		end--
+	}
+
+	return ins.placement(end, " //nolint:", " // "+ins.reason)
+}
+
+// abovePlacement returns the placement on a new line above the node, suppressing the issues of the whole node,
+// or nil if the node isn't the first element of its line.
+func (ins *nolintInserter) abovePlacement(node ast.Node) *nolintPlacement {
+	if node == nil {
+		return nil
+	}
+
+	pos := ins.fset.Position(node.Pos())
+	lineStart := ins.lineStarts[pos.Line-1]
+
+	indent := ins.data[lineStart : lineStart+pos.Column-1]
+	if len(bytes.TrimLeft(indent, " \t")) != 0 {
+		return nil
+	}
+
+	// The directive right above the node is extended.
+	for _, group := range ins.file.Comments {
+		for _, c := range group.List {
+			cPos := ins.fset.Position(c.Pos())
+			if cPos.Line == pos.Line-1 && cPos.Column == pos.Column && nolintCommentPattern.MatchString(c.Text) {
+				return ins.existingPlacement(c)
+			}
+		}
+	}
+
+	return ins.placement(lineStart, string(indent)+"//nolint:", " // "+ins.reason+"\n")
+}
+
+// existingPlacement returns the placement extending the linters of the directive,
+// or nil if the directive applies to all the linters.
+func (ins *nolintInserter) existingPlacement(c *ast.Comment) *nolintPlacement {
+	loc := nolintLintersPattern.FindStringSubmatchIndex(c.Text)
+	if loc == nil {
+		return nil
+	}
+
+	var existing []string
+	for _, linter := range strings.Split(c.Text[loc[2]:loc[3]], ",") {
+		existing = append(existing, strings.ToLower(strings.TrimSpace(linter)))
+	}
+
+	if slices.Contains(existing, "all") {
+		return nil
+	}
+
+	pl := ins.placement(ins.fset.File(c.Pos()).Offset(c.Pos())+loc[1], ",", "")
+	pl.existing = existing
+
+	return pl
+}
+
+func (ins *nolintInserter) placement(pos int, prefix, suffix string) *nolintPlacement {
+	if pl, ok := ins.placements[pos]; ok {
+		return pl
+	}
+
+	pl := &nolintPlacement{pos: pos, prefix: prefix, suffix: suffix, linters: map[string]bool{}}
+	ins.placements[pos] = pl
+
+	return pl
+}
+
+// declaration returns the top-level declaration containing the line, with its doc comment.
+func (ins *nolintInserter) declaration(line int) ast.Decl {
+	for _, decl := range ins.file.Decls {
+		if from, to := ins.lines(decl); from <= line && line <= to {
+			return decl
+		}
+	}
+
+	return nil
+}
+
+// enclosingNode returns the innermost statement or declaration containing the line
+// which is the first element of its line, so a directive can be placed above it.
+func (ins *nolintInserter) enclosingNode(line int) ast.Node {
+	var found ast.Node
+
+	ast.Inspect(ins.file, func(node ast.Node) bool {
+		if node == nil {
+			return false
+		}
+
+		if _, ok := node.(*ast.File); ok {
+			return true
+		}
+
+		if from, to := ins.lines(node); line < from || line > to {
+			return false
+		}
+
+		switch node.(type) {
+		case *ast.BlockStmt:
+		case ast.Stmt, ast.Decl:
+			if ins.firstOnLine(node) {
+				found = node
+			}
+		}
+
+		return true
+	})
+
+	return found
+}
+
+// lines returns the lines of the node, with the doc comment of a declaration.
+func (ins *nolintInserter) lines(node ast.Node) (from, to int) {
+	start := node.Pos()
+
+	switch decl := node.(type) {
+	case *ast.FuncDecl:
+		if decl.Doc != nil {
+			start = decl.Doc.Pos()
+		}
+	case *ast.GenDecl:
+		if decl.Doc != nil {
+			start = decl.Doc.Pos()
+		}
+	}
+
+	return ins.line(start), ins.line(node.End())
+}
+
+func (ins *nolintInserter) firstOnLine(node ast.Node) bool {
+	pos := ins.fset.Position(node.Pos())
+	lineStart := ins.lineStarts[pos.Line-1]
+
+	return len(bytes.TrimLeft(ins.data[lineStart:lineStart+pos.Column-1], " \t")) == 0
+}
+
+func (ins *nolintInserter) line(pos token.Pos) int {
+	return ins.fset.Position(pos).Line
+}
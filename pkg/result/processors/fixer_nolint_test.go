@@ -0,0 +1,105 @@
+package processors
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+func TestFixer_Process_addNolint(t *testing.T) {
+	type issue struct {
+		line   int
+		linter string
+	}
+
+	testCases := []struct {
+		desc             string
+		data             string
+		issues           []issue
+		expected         string
+		expectedNotFixed int
+	}{
+		{
+			desc:     "inline directives",
+			data:     "package p\n\nfunc f() {\n\tfoo()\n\tbar()\n}\n",
+			issues:   []issue{{4, "errcheck"}, {4, "gosec"}, {5, "errcheck"}},
+			expected: "package p\n\nfunc f() {\n\tfoo() //nolint:errcheck,gosec // legacy\n\tbar() //nolint:errcheck // legacy\n}\n",
+		},
+		{
+			desc:     "existing directive",
+			data:     "package p\n\nfunc f() {\n\tfoo() //nolint:gosec until=2999-12-31 // why\n}\n",
+			issues:   []issue{{4, "errcheck"}},
+			expected: "package p\n\nfunc f() {\n\tfoo() //nolint:gosec,errcheck until=2999-12-31 // why\n}\n",
+		},
+		{
+			desc:     "trailing comment",
+			data:     "package p\n\nfunc f() {\n\tfoo() // comment\n}\n",
+			issues:   []issue{{4, "errcheck"}},
+			expected: "package p\n\nfunc f() {\n\t//nolint:errcheck // legacy\n\tfoo() // comment\n}\n",
+		},
+		{
+			desc:     "multi-line string",
+			data:     "package p\n\nfunc f() {\n\tfoo(`a\nb`)\n}\n",
+			issues:   []issue{{4, "errcheck"}},
+			expected: "package p\n\nfunc f() {\n\t//nolint:errcheck // legacy\n\tfoo(`a\nb`)\n}\n",
+		},
+		{
+			desc:     "declaration",
+			data:     "package p\n\n// f does things.\nfunc f() {\n\tfoo()\n\tfoo()\n\tfoo()\n}\n",
+			issues:   []issue{{5, "errcheck"}, {6, "errcheck"}, {7, "errcheck"}, {7, "gosec"}},
+			expected: "package p\n\n// f does things.\n//nolint:errcheck // legacy\nfunc f() {\n\tfoo()\n\tfoo()\n\tfoo() //nolint:gosec // legacy\n}\n",
+		},
+		{
+			desc:     "file",
+			data:     "package p\n\nfunc f() { foo() }\n\nfunc g() { foo() }\n\nfunc h() { foo() }\n",
+			issues:   []issue{{3, "errcheck"}, {5, "errcheck"}, {7, "errcheck"}},
+			expected: "//nolint:errcheck // legacy\npackage p\n\nfunc f() { foo() }\n\nfunc g() { foo() }\n\nfunc h() { foo() }\n",
+		},
+		{
+			desc:             "type checking error",
+			data:             "package p\n\nfunc f() {\n\tfoo()\n}\n",
+			issues:           []issue{{4, typeCheckName}},
+			expected:         "package p\n\nfunc f() {\n\tfoo()\n}\n",
+			expectedNotFixed: 1,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			filePath := filepath.Join(t.TempDir(), "fixer.go")
+
+			err := os.WriteFile(filePath, []byte(test.data), 0o600)
+			require.NoError(t, err)
+
+			p := newTestFixer()
+			p.cfg.Issues = config.Issues{AddNolint: "legacy", FixMode: config.FixModeWrite}
+
+			var issues []result.Issue
+			for _, i := range test.issues {
+				issues = append(issues, result.Issue{
+					FromLinter: i.linter,
+					Pos:        token.Position{Filename: filePath, Line: i.line},
+				})
+			}
+
+			notFixed, err := p.Process(issues)
+			require.NoError(t, err)
+
+			data, err := os.ReadFile(filePath)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, string(data))
+			assert.Len(t, notFixed, test.expectedNotFixed)
+		})
+	}
+}
@@ -0,0 +1,268 @@
+package processors
+
+import (
+	"bufio"
+	"bytes"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/fsutils"
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+func newFixIssue(line int, replacement *result.Replacement) result.Issue {
+	return result.Issue{
+		FromLinter:  "test",
+		Pos:         token.Position{Filename: "fixer.go", Line: line},
+		Replacement: replacement,
+	}
+}
+
+func newTestFixer() *Fixer {
+	return NewFixer(&config.Config{}, logutils.NewStderrLog(logutils.DebugKeyEmpty), fsutils.NewFileCache())
+}
+
+func TestFixer_applyFixes(t *testing.T) {
+	testCases := []struct {
+		desc             string
+		data             string
+		issues           []result.Issue
+		expected         string
+		expectedNotFixed int
+	}{
+		{
+			desc: "text edits in several places",
+			data: "package p\n\nfunc f() {\n\tfoo()\n}\n",
+			issues: []result.Issue{
+				newFixIssue(4, &result.Replacement{TextEdits: []result.TextEdit{
+					{Pos: 23, End: 26, NewText: "bar"},
+					{Pos: 10, End: 10, NewText: "import \"bar\"\n"},
+				}}),
+			},
+			expected: "package p\nimport \"bar\"\n\nfunc f() {\n\tbar()\n}\n",
+		},
+		{
+			desc: "inline fixes on the same line",
+			data: "a := foo(bar)\n",
+			issues: []result.Issue{
+				newFixIssue(1, &result.Replacement{Inline: &result.InlineFix{StartCol: 9, Length: 3, NewString: "baz"}}),
+				newFixIssue(1, &result.Replacement{Inline: &result.InlineFix{StartCol: 5, Length: 3, NewString: "qux"}}),
+			},
+			expected: "a := qux(baz)\n",
+		},
+		{
+			desc: "lines replacement and deletion",
+			data: "a\nb\nc\nd\n",
+			issues: []result.Issue{
+				newFixIssue(1, &result.Replacement{NewLines: []string{"x", "y"}}),
+				{
+					Pos:         token.Position{Filename: "fixer.go", Line: 2},
+					LineRange:   &result.Range{From: 2, To: 3},
+					Replacement: &result.Replacement{NeedOnlyDelete: true},
+				},
+			},
+			expected: "x\ny\nd\n",
+		},
+		{
+			desc: "identical edits are merged",
+			data: "package p\n",
+			issues: []result.Issue{
+				newFixIssue(1, &result.Replacement{TextEdits: []result.TextEdit{{Pos: 10, End: 10, NewText: "import \"fmt\"\n"}}}),
+				newFixIssue(1, &result.Replacement{TextEdits: []result.TextEdit{{Pos: 10, End: 10, NewText: "import \"fmt\"\n"}}}),
+			},
+			expected: "package p\nimport \"fmt\"\n",
+		},
+		{
+			desc: "conflicting issues",
+			data: "a := foo(bar)\n",
+			issues: []result.Issue{
+				newFixIssue(1, &result.Replacement{Inline: &result.InlineFix{StartCol: 5, Length: 8, NewString: "qux()"}}),
+				newFixIssue(1, &result.Replacement{TextEdits: []result.TextEdit{
+					{Pos: 0, End: 1, NewText: "b"},
+					{Pos: 9, End: 12, NewText: "baz"},
+				}}),
+			},
+			expected:         "b := foo(baz)\n",
+			expectedNotFixed: 1,
+		},
+		{
+			desc: "conflicting issues with the same fix",
+			data: "a := foo(bar)\n",
+			issues: []result.Issue{
+				newFixIssue(1, &result.Replacement{NewLines: []string{"a := qux(bar)"}}),
+				newFixIssue(1, &result.Replacement{Inline: &result.InlineFix{StartCol: 5, Length: 3, NewString: "qux"}}),
+			},
+			expected: "a := qux(bar)\n",
+		},
+		{
+			desc: "conflicting issues replaced by the accepted edits",
+			data: "a := foo(bar)\n",
+			issues: []result.Issue{
+				newFixIssue(1, &result.Replacement{NewLines: []string{"a := qux(bar)"}}),
+				newFixIssue(1, &result.Replacement{Inline: &result.InlineFix{StartCol: 9, Length: 3, NewString: "baz"}}),
+			},
+			expected: "a := qux(bar)\n",
+		},
+		{
+			desc: "invalid edits",
+			data: "a\n",
+			issues: []result.Issue{
+				newFixIssue(1, &result.Replacement{TextEdits: []result.TextEdit{{Pos: 1, End: 10, NewText: "b"}}}),
+				newFixIssue(1, &result.Replacement{Inline: &result.InlineFix{StartCol: 1, Length: 2, NewString: "b"}}),
+				newFixIssue(3, &result.Replacement{NewLines: []string{"b"}}),
+			},
+			expected:         "a\n",
+			expectedNotFixed: 3,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			fixed, notFixed := newTestFixer().applyFixes([]byte(test.data), test.issues)
+
+			assert.Equal(t, test.expected, string(fixed))
+			assert.Len(t, notFixed, test.expectedNotFixed)
+		})
+	}
+}
+
+func TestFixer_Process_diff(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "fixer.go")
+
+	err := os.WriteFile(filePath, []byte("a := foo(bar)\n"), 0o600)
+	require.NoError(t, err)
+
+	out := &bytes.Buffer{}
+
+	p := newTestFixer()
+	p.cfg.Issues = config.Issues{NeedFix: true, FixMode: config.FixModeDiff}
+	p.out = out
+
+	issue := newFixIssue(1, &result.Replacement{Inline: &result.InlineFix{StartCol: 5, Length: 3, NewString: "qux"}})
+	issue.Pos.Filename = filePath
+
+	issues, err := p.Process([]result.Issue{issue})
+	require.NoError(t, err)
+
+	assert.Len(t, issues, 1)
+	assert.Contains(t, out.String(), "-a := foo(bar)\n+a := qux(bar)\n")
+
+	data, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+
+	assert.Equal(t, "a := foo(bar)\n", string(data))
+}
+
+func TestFixer_Process_interactive(t *testing.T) {
+	testCases := []struct {
+		desc             string
+		answers          string
+		expected         string
+		expectedNotFixed int
+	}{
+		{
+			desc:     "accept all",
+			answers:  "y\nyes\n",
+			expected: "a := qux(baz)\n",
+		},
+		{
+			desc:             "accept one",
+			answers:          "n\ny\n",
+			expected:         "a := foo(baz)\n",
+			expectedNotFixed: 1,
+		},
+		{
+			desc:             "invalid answer",
+			answers:          "foo\ny\nn\n",
+			expected:         "a := qux(bar)\n",
+			expectedNotFixed: 1,
+		},
+		{
+			desc:             "quit",
+			answers:          "q\n",
+			expected:         "a := foo(bar)\n",
+			expectedNotFixed: 2,
+		},
+		{
+			desc:             "end of input",
+			answers:          "y\n",
+			expected:         "a := qux(bar)\n",
+			expectedNotFixed: 1,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			filePath := filepath.Join(t.TempDir(), "fixer.go")
+
+			err := os.WriteFile(filePath, []byte("a := foo(bar)\n"), 0o600)
+			require.NoError(t, err)
+
+			p := newTestFixer()
+			p.cfg.Issues = config.Issues{NeedFix: true, FixMode: config.FixModeInteractive}
+			p.out = io.Discard
+			p.in = bufio.NewReader(strings.NewReader(test.answers))
+
+			issues := []result.Issue{
+				newFixIssue(1, &result.Replacement{Inline: &result.InlineFix{StartCol: 5, Length: 3, NewString: "qux"}}),
+				newFixIssue(1, &result.Replacement{Inline: &result.InlineFix{StartCol: 9, Length: 3, NewString: "baz"}}),
+			}
+			issues[0].Pos.Filename, issues[0].Pos.Column = filePath, 6
+			issues[1].Pos.Filename, issues[1].Pos.Column = filePath, 10
+
+			notFixed, err := p.Process(issues)
+			require.NoError(t, err)
+
+			assert.Len(t, notFixed, test.expectedNotFixed)
+
+			data, err := os.ReadFile(filePath)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, string(data))
+		})
+	}
+}
+
+func TestFixer_Process_interactiveSameFix(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "fixer.go")
+
+	err := os.WriteFile(filePath, []byte("a := foo(bar)\n"), 0o600)
+	require.NoError(t, err)
+
+	p := newTestFixer()
+	p.cfg.Issues = config.Issues{NeedFix: true, FixMode: config.FixModeInteractive}
+	p.out = io.Discard
+	p.in = bufio.NewReader(strings.NewReader("y\nn\n"))
+
+	issues := []result.Issue{
+		newFixIssue(1, &result.Replacement{Inline: &result.InlineFix{StartCol: 5, Length: 3, NewString: "qux"}}),
+		newFixIssue(1, &result.Replacement{Inline: &result.InlineFix{StartCol: 5, Length: 3, NewString: "qux"}}),
+	}
+	issues[0].Pos.Filename, issues[1].Pos.Filename = filePath, filePath
+
+	// The declined issue is fixed by the accepted fix.
+	notFixed, err := p.Process(issues)
+	require.NoError(t, err)
+
+	assert.Empty(t, notFixed)
+
+	data, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+
+	assert.Equal(t, "a := qux(bar)\n", string(data))
+}
@@ -39,6 +39,11 @@ func (p *MaxFromLinter) Process(issues []result.Issue) ([]result.Issue, error) {
 			return true
 		}
 
+		if p.cfg.Issues.AddNolint != "" {
+			// all the issues need a nolint directive
+			return true
+		}
+
 		p.linterCounter[issue.FromLinter]++ // always inc for stat
 
 		return p.linterCounter[issue.FromLinter] <= p.limit
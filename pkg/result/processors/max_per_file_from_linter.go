@@ -15,9 +15,9 @@ type MaxPerFileFromLinter struct {
 func NewMaxPerFileFromLinter(cfg *config.Config) *MaxPerFileFromLinter {
 	maxPerFileFromLinterConfig := map[string]int{}
 
-	if !cfg.Issues.NeedFix {
+	if !cfg.Issues.NeedFix && cfg.Issues.AddNolint == "" {
 		// if we don't fix we do this limiting to not annoy user;
-		// otherwise we need to fix all issues in the file at once
+		// otherwise we need to fix (or suppress) all issues in the file at once
 		maxPerFileFromLinterConfig["gofmt"] = 1
 		maxPerFileFromLinterConfig["goimports"] = 1
 	}
@@ -41,6 +41,11 @@ func (p *MaxSameIssues) Process(issues []result.Issue) ([]result.Issue, error) {
 			return true
 		}
 
+		if p.cfg.Issues.AddNolint != "" {
+			// all the issues need a nolint directive
+			return true
+		}
+
 		p.textCounter[issue.Text]++ // always inc for stat
 		return p.textCounter[issue.Text] <= p.limit
 	}), nil
@@ -5,6 +5,7 @@ import (
 	"go/parser"
 	"go/token"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 
@@ -21,11 +22,15 @@ var _ Processor = (*Nolint)(nil)
 
 var nolintDebugf = logutils.Debug(logutils.DebugKeyNolint)
 
+// nolintAttributePattern matches the attributes of a nolint directive (ex: `until=2026-12-31`, `issue=PROJ-123`).
+var nolintAttributePattern = regexp.MustCompile(`\s+[\w-]+=\S*`)
+
 type ignoredRange struct {
 	linters                []string
 	matchedIssueFromLinter map[string]bool
 	result.Range
 	col           int
+	reason        string        // the comment after the directive: `//nolint:xxx // reason`
 	originalRange *ignoredRange // pre-expanded range (used to match nolintlint issues)
 }
 
@@ -60,6 +65,21 @@ func (i *ignoredRange) doesMatch(issue *result.Issue) bool {
 	return false
 }
 
+// NolintDirective is a nolint directive of a file.
+type NolintDirective struct {
+	File   string
+	Line   int
+	Column int
+	// The linters named by the directive, or nil for all the linters.
+	Linters []string
+	// The comment after the directive: `//nolint:xxx // reason`.
+	Reason string
+	// The lines covered by the directive: the directive itself, or the declaration or the statement below it.
+	Range result.Range
+	// The linters of the issues suppressed by the directive during the run.
+	Suppressed []string
+}
+
 type fileData struct {
 	ignoredRanges []ignoredRange
 }
@@ -73,9 +93,13 @@ type Nolint struct {
 	unknownLintersSet map[string]bool
 
 	pattern *regexp.Regexp
+
+	keepSuppressed bool
 }
 
-func NewNolint(log logutils.Log, dbManager *lintersdb.Manager, enabledLinters map[string]*linter.Config) *Nolint {
+func NewNolint(log logutils.Log, dbManager *lintersdb.Manager, enabledLinters map[string]*linter.Config,
+	keepSuppressed bool,
+) *Nolint {
 	return &Nolint{
 		fileCache:         map[string]*fileData{},
 		dbManager:         dbManager,
@@ -83,6 +107,7 @@ func NewNolint(log logutils.Log, dbManager *lintersdb.Manager, enabledLinters ma
 		log:               log,
 		unknownLintersSet: map[string]bool{},
 		pattern:           regexp.MustCompile(`^nolint( |:|$)`),
+		keepSuppressed:    keepSuppressed,
 	}
 }
 
@@ -121,7 +146,7 @@ func (p *Nolint) shouldPassIssue(issue *result.Issue) (bool, error) {
 		nolintDebugf("checking that lint issue was used for %s: %v", issue.ExpectedNoLintLinter, issue)
 	}
 
-	fd := p.getOrCreateFileData(issue)
+	fd := p.getOrCreateFileData(issue.FilePath())
 
 	for _, ir := range fd.ignoredRanges {
 		if !ir.doesMatch(issue) {
@@ -136,39 +161,91 @@ func (p *Nolint) shouldPassIssue(issue *result.Issue) (bool, error) {
 			ir.originalRange.matchedIssueFromLinter[issue.FromLinter] = true
 		}
 
+		// The nolintlint issues about the directives themselves are not kept.
+		if p.keepSuppressed && !issue.ExpectNoLint {
+			issue.Suppression = &result.Suppression{Kind: result.SuppressionInSource, Justification: ir.reason}
+			return true, nil
+		}
+
 		return false, nil
 	}
 
 	return true, nil
 }
 
-func (p *Nolint) getOrCreateFileData(issue *result.Issue) *fileData {
-	fd := p.fileCache[issue.FilePath()]
+func (p *Nolint) getOrCreateFileData(filePath string) *fileData {
+	fd := p.fileCache[filePath]
 	if fd != nil {
 		return fd
 	}
 
 	fd = &fileData{}
-	p.fileCache[issue.FilePath()] = fd
+	p.fileCache[filePath] = fd
 
 	// TODO: migrate this parsing to go/analysis facts
 	// or cache them somehow per file.
 
 	// Don't use cached AST because they consume a lot of memory on large projects.
 	fset := token.NewFileSet()
-	f, err := parser.ParseFile(fset, issue.FilePath(), nil, parser.ParseComments)
+	f, err := parser.ParseFile(fset, filePath, nil, parser.ParseComments)
 	if err != nil {
 		// Don't report error because it's already must be reporter by typecheck or go/analysis.
 		return fd
 	}
 
-	fd.ignoredRanges = p.buildIgnoredRangesForFile(f, fset, issue.FilePath())
+	fd.ignoredRanges = p.buildIgnoredRangesForFile(f, fset, filePath)
 
-	nolintDebugf("file %s: built nolint ranges are %+v", issue.FilePath(), fd.ignoredRanges)
+	nolintDebugf("file %s: built nolint ranges are %+v", filePath, fd.ignoredRanges)
 
 	return fd
 }
 
+// Directives returns the nolint directives of the files (relative to the working directory),
+// with the linters of the issues they suppressed during the run.
+// The files are parsed like the files of the issues.
+func (p *Nolint) Directives(filePaths []string) []NolintDirective {
+	var directives []NolintDirective
+
+	for _, filePath := range filePaths {
+		fd := p.getOrCreateFileData(filePath)
+
+		for _, ir := range fd.ignoredRanges {
+			if ir.originalRange != nil {
+				continue // an expanded range is reported with its directive
+			}
+
+			directive := NolintDirective{
+				File:    filePath,
+				Line:    ir.From,
+				Column:  ir.col,
+				Linters: ir.linters,
+				Reason:  ir.reason,
+				Range:   ir.Range,
+			}
+
+			for _, expanded := range fd.ignoredRanges {
+				if expanded.originalRange != nil && expanded.From == ir.From && expanded.col == ir.col {
+					directive.Range.To = max(directive.Range.To, expanded.To)
+				}
+			}
+
+			// The expanded ranges share the matched linters of their directive.
+			// The nolintlint issues about the directive itself only count if the directive names nolintlint.
+			for linterName := range ir.matchedIssueFromLinter {
+				if linterName != nolintlint.LinterName || slices.Contains(ir.linters, nolintlint.LinterName) {
+					directive.Suppressed = append(directive.Suppressed, linterName)
+				}
+			}
+
+			sort.Strings(directive.Suppressed)
+
+			directives = append(directives, directive)
+		}
+	}
+
+	return directives
+}
+
 func (p *Nolint) buildIgnoredRangesForFile(f *ast.File, fset *token.FileSet, filePath string) []ignoredRange {
 	inlineRanges := p.extractFileCommentsInlineRanges(fset, f.Comments...)
 	nolintDebugf("file %s: inline nolint ranges are %+v", filePath, inlineRanges)
@@ -211,6 +288,11 @@ func (p *Nolint) extractInlineRangeFromComment(text string, g ast.Node, fset *to
 		return nil
 	}
 
+	var reason string
+	if _, after, found := strings.Cut(text, "//"); found {
+		reason = strings.TrimSpace(after)
+	}
+
 	buildRange := func(linters []string) *ignoredRange {
 		pos := fset.Position(g.Pos())
 		return &ignoredRange{
@@ -219,6 +301,7 @@ func (p *Nolint) extractInlineRangeFromComment(text string, g ast.Node, fset *to
 				To:   fset.Position(g.End()).Line,
 			},
 			col:                    pos.Column,
+			reason:                 reason,
 			linters:                linters,
 			matchedIssueFromLinter: make(map[string]bool),
 		}
@@ -231,6 +314,8 @@ func (p *Nolint) extractInlineRangeFromComment(text string, g ast.Node, fset *to
 	// ignore specific linters
 	var linters []string
 	text = strings.Split(text, "//")[0] // allow another comment after this comment
+	// the attributes (ex: `until=2026-12-31`) are checked by nolintlint
+	text = nolintAttributePattern.ReplaceAllString(text, "")
 	linterItems := strings.Split(strings.TrimPrefix(text, "nolint:"), ",")
 	for _, item := range linterItems {
 		linterName := strings.ToLower(strings.TrimSpace(item))
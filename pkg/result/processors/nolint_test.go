@@ -36,7 +36,7 @@ func newNolint2FileIssue(line int) result.Issue {
 func newTestNolintProcessor(log logutils.Log) *Nolint {
 	dbManager, _ := lintersdb.NewManager(log, config.NewDefault(), lintersdb.NewLinterBuilder())
 
-	return NewNolint(log, dbManager, nil)
+	return NewNolint(log, dbManager, nil, false)
 }
 
 func getMockLog() *logutils.MockLog {
@@ -274,6 +274,76 @@ func TestNolintWholeFile(t *testing.T) {
 	})
 }
 
+func TestNolintAttributes(t *testing.T) {
+	fileName := filepath.Join("testdata", "nolint_attributes.go")
+
+	p := newTestNolintProcessor(getMockLog())
+	defer p.Finish()
+
+	newIssue := func(line int, fromLinter string) result.Issue {
+		return result.Issue{
+			Pos: token.Position{
+				Filename: fileName,
+				Line:     line,
+			},
+			FromLinter: fromLinter,
+		}
+	}
+
+	processAssertEmpty(t, p, newIssue(8, "errcheck"), newIssue(9, "errcheck"), newIssue(10, "gosec"))
+	processAssertSame(t, p, newIssue(10, "errcheck"))
+}
+
+func TestNolint_Directives(t *testing.T) {
+	fileName := filepath.Join("testdata", "nolint2.go")
+
+	p := newTestNolintProcessor(getMockLog())
+	defer p.Finish()
+
+	processAssertEmpty(t, p, newNolint2FileIssue(10))
+
+	expected := []NolintDirective{
+		{
+			File:       fileName,
+			Line:       9,
+			Column:     2,
+			Linters:    []string{"errcheck"},
+			Range:      result.Range{From: 9, To: 10},
+			Suppressed: []string{"errcheck"},
+		},
+		{
+			File:    fileName,
+			Line:    14,
+			Column:  47,
+			Linters: []string{"errcheck"},
+			Range:   result.Range{From: 14, To: 14},
+		},
+	}
+
+	assert.Equal(t, expected, p.Directives([]string{fileName}))
+}
+
+func TestNolintKeepSuppressed(t *testing.T) {
+	log := getMockLog()
+	dbManager, err := lintersdb.NewManager(log, config.NewDefault(), lintersdb.NewLinterBuilder())
+	require.NoError(t, err)
+
+	p := NewNolint(log, dbManager, nil, true)
+	defer p.Finish()
+
+	processed := process(t, p, newNolintFileIssue(3, "gofmt"), newNolintFileIssue(7, "govet"), newNolintFileIssue(1, "golint"))
+	require.Len(t, processed, 3)
+
+	assert.Equal(t, 3, processed[0].Line())
+	assert.Equal(t, &result.Suppression{Kind: result.SuppressionInSource}, processed[0].Suppression)
+
+	assert.Equal(t, 7, processed[1].Line())
+	assert.Equal(t, &result.Suppression{Kind: result.SuppressionInSource, Justification: "another comment"}, processed[1].Suppression)
+
+	assert.Equal(t, 1, processed[2].Line())
+	assert.Nil(t, processed[2].Suppression)
+}
+
 func TestNolintUnused(t *testing.T) {
 	fileName := filepath.Join("testdata", "nolint_unused.go")
 
@@ -292,7 +362,7 @@ func TestNolintUnused(t *testing.T) {
 		enabledLintersMap, err := dbManager.GetEnabledLintersMap()
 		require.NoError(t, err)
 
-		return NewNolint(log, dbManager, enabledLintersMap)
+		return NewNolint(log, dbManager, enabledLintersMap, false)
 	}
 
 	// the issue below is the nolintlint issue that would be generated for the test file
@@ -356,7 +426,7 @@ func TestNolintUnused(t *testing.T) {
 		enabledLintersMap, err := dbManager.GetEnabledLintersMap()
 		require.NoError(t, err)
 
-		p := NewNolint(log, dbManager, enabledLintersMap)
+		p := NewNolint(log, dbManager, enabledLintersMap, false)
 		defer p.Finish()
 
 		processAssertEmpty(t, p, nolintlintIssueVarcheck)
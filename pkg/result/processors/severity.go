@@ -27,6 +27,7 @@ type Severity struct {
 
 	defaultSeverity string
 	rules           []severityRule
+	exclusionsCounter
 }
 
 func NewSeverity(log logutils.Log, files *fsutils.Files, cfg *config.Severity) *Severity {
@@ -45,6 +46,10 @@ func NewSeverity(log logutils.Log, files *fsutils.Files, cfg *config.Severity) *
 
 	p.rules = createSeverityRules(cfg.Rules, prefix)
 
+	for i := range cfg.Rules {
+		p.add("severity rule", "severity.rules", i, describeRule(&cfg.Rules[i].BaseRule))
+	}
+
 	return p
 }
 
@@ -61,8 +66,10 @@ func (p *Severity) Process(issues []result.Issue) ([]result.Issue, error) {
 func (*Severity) Finish() {}
 
 func (p *Severity) transform(issue *result.Issue) *result.Issue {
-	for _, rule := range p.rules {
+	for i, rule := range p.rules {
 		if rule.match(issue, p.files, p.log) {
+			p.match(i)
+
 			if rule.severity == severityFromLinter || (rule.severity == "" && p.defaultSeverity == severityFromLinter) {
 				return issue
 			}
@@ -89,6 +96,7 @@ func createSeverityRules(rules []config.SeverityRule, prefix string) []severityR
 	for _, rule := range rules {
 		parsedRule := severityRule{}
 		parsedRule.linters = rule.Linters
+		parsedRule.ruleIDs = rule.RuleIDs
 		parsedRule.severity = rule.Severity
 
 		if rule.Text != "" {
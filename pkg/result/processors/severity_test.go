@@ -214,6 +214,37 @@ func TestSeverity_text(t *testing.T) {
 	assert.Equal(t, texts, processedTexts)
 }
 
+func TestSeverity_ruleIDs(t *testing.T) {
+	opts := &config.Severity{
+		Default: "error",
+		Rules: []config.SeverityRule{
+			{
+				Severity: "info",
+				BaseRule: config.BaseRule{
+					RuleIDs: []string{"G104"},
+				},
+			},
+		},
+	}
+
+	p := NewSeverity(nil, nil, opts)
+
+	issues := []result.Issue{
+		{FromLinter: "gosec", RuleID: "G104"},
+		{FromLinter: "gosec", RuleID: "G103"},
+		{FromLinter: "errcheck"},
+	}
+
+	processedIssues := process(t, p, issues...)
+
+	var severities []string
+	for _, i := range processedIssues {
+		severities = append(severities, i.Severity)
+	}
+
+	assert.Equal(t, []string{"info", "error", "error"}, severities)
+}
+
 func TestSeverity_onlyDefault(t *testing.T) {
 	lineCache := fsutils.NewLineCache(fsutils.NewFileCache())
 	files := fsutils.NewFiles(lineCache, "")
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"regexp"
+	"slices"
 
 	"github.com/snowmerak/golangci-lint/pkg/fsutils"
 	"github.com/snowmerak/golangci-lint/pkg/logutils"
@@ -22,7 +23,7 @@ var StdExcludeDirRegexps = []string{
 }
 
 type skipStat struct {
-	pattern string
+	pattern int
 	count   int
 }
 
@@ -33,11 +34,15 @@ type SkipDirs struct {
 	absArgsDirs      []string
 	skippedDirsCache map[string]bool
 	pathPrefix       string
+	exclusionsCounter
 }
 
 func NewSkipDirs(log logutils.Log, patterns, args []string, pathPrefix string) (*SkipDirs, error) {
 	var patternsRe []*regexp.Regexp
-	for _, p := range patterns {
+	var counter exclusionsCounter
+	for i, p := range patterns {
+		counter.add("exclude-dirs pattern", "issues.exclude-dirs", i, p)
+
 		p = fsutils.NormalizePathInRegex(p)
 		patternRe, err := regexp.Compile(p)
 		if err != nil {
@@ -52,12 +57,13 @@ func NewSkipDirs(log logutils.Log, patterns, args []string, pathPrefix string) (
 	}
 
 	return &SkipDirs{
-		patterns:         patternsRe,
-		log:              log,
-		skippedDirs:      map[string]*skipStat{},
-		absArgsDirs:      absArgsDirs,
-		skippedDirsCache: map[string]bool{},
-		pathPrefix:       pathPrefix,
+		patterns:          patternsRe,
+		log:               log,
+		skippedDirs:       map[string]*skipStat{},
+		absArgsDirs:       absArgsDirs,
+		skippedDirsCache:  map[string]bool{},
+		pathPrefix:        pathPrefix,
+		exclusionsCounter: counter,
 	}, nil
 }
 
@@ -75,10 +81,18 @@ func (p *SkipDirs) Process(issues []result.Issue) ([]result.Issue, error) {
 
 func (p *SkipDirs) Finish() {
 	for dir, stat := range p.skippedDirs {
-		p.log.Infof("Skipped %d issues from dir %s by pattern %s", stat.count, dir, stat.pattern)
+		p.log.Infof("Skipped %d issues from dir %s by pattern %s", stat.count, dir, p.patterns[stat.pattern])
 	}
 }
 
+// Exclusions returns the excluded dirs of the configuration with the numbers of issues they matched.
+// The default excluded dirs are not returned.
+func (p *SkipDirs) Exclusions() []Exclusion {
+	return slices.DeleteFunc(p.exclusionsCounter.Exclusions(), func(exclusion Exclusion) bool {
+		return slices.Contains(StdExcludeDirRegexps, exclusion.Description)
+	})
+}
+
 func (p *SkipDirs) shouldPassIssue(issue *result.Issue) bool {
 	if filepath.IsAbs(issue.FilePath()) {
 		if isGoFile(issue.FilePath()) {
@@ -91,7 +105,9 @@ func (p *SkipDirs) shouldPassIssue(issue *result.Issue) bool {
 
 	if toPass, ok := p.skippedDirsCache[issueRelDir]; ok {
 		if !toPass {
-			p.skippedDirs[issueRelDir].count++
+			stat := p.skippedDirs[issueRelDir]
+			stat.count++
+			p.match(stat.pattern)
 		}
 		return toPass
 	}
@@ -107,12 +123,41 @@ func (p *SkipDirs) shouldPassIssue(issue *result.Issue) bool {
 	return toPass
 }
 
+// SkipsDir reports whether the issues of the directory (relative to the working directory) are skipped.
+// It is also used to exclude the directories from the watched paths.
+func (p *SkipDirs) SkipsDir(relDir string) bool {
+	absDir, err := filepath.Abs(relDir)
+	if err != nil {
+		return false
+	}
+
+	return p.matchDir(relDir, absDir) >= 0
+}
+
 func (p *SkipDirs) shouldPassIssueDirs(issueRelDir, issueAbsDir string) bool {
+	ps := p.matchDir(issueRelDir, issueAbsDir)
+	if ps < 0 {
+		return true
+	}
+
+	if p.skippedDirs[issueRelDir] == nil {
+		p.skippedDirs[issueRelDir] = &skipStat{
+			pattern: ps,
+		}
+	}
+	p.skippedDirs[issueRelDir].count++
+	p.match(ps)
+
+	return false
+}
+
+// matchDir returns the index of the pattern matching the directory, or -1.
+func (p *SkipDirs) matchDir(issueRelDir, issueAbsDir string) int {
 	for _, absArgDir := range p.absArgsDirs {
 		if absArgDir == issueAbsDir {
 			// we must not skip issues if they are from explicitly set dirs
 			// even if they match skip patterns
-			return true
+			return -1
 		}
 	}
 
@@ -123,20 +168,13 @@ func (p *SkipDirs) shouldPassIssueDirs(issueRelDir, issueAbsDir string) bool {
 	// disadvantages (https://github.com/golangci/golangci-lint/pull/313).
 
 	path := fsutils.WithPathPrefix(p.pathPrefix, issueRelDir)
-	for _, pattern := range p.patterns {
+	for i, pattern := range p.patterns {
 		if pattern.MatchString(path) {
-			ps := pattern.String()
-			if p.skippedDirs[issueRelDir] == nil {
-				p.skippedDirs[issueRelDir] = &skipStat{
-					pattern: ps,
-				}
-			}
-			p.skippedDirs[issueRelDir].count++
-			return false
+			return i
 		}
 	}
 
-	return true
+	return -1
 }
 
 func absDirs(args []string) ([]string, error) {
@@ -8,6 +8,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
 )
 
 func Test_absDirs(t *testing.T) {
@@ -100,3 +102,13 @@ func mustAbs(t *testing.T, p string) string {
 
 	return abs
 }
+
+func TestSkipDirs_SkipsDir(t *testing.T) {
+	p, err := NewSkipDirs(logutils.NewStderrLog(logutils.DebugKeyEmpty), StdExcludeDirRegexps, []string{"./..."}, "")
+	require.NoError(t, err)
+
+	assert.True(t, p.SkipsDir("vendor"))
+	assert.True(t, p.SkipsDir(filepath.FromSlash("foo/testdata")))
+	assert.False(t, p.SkipsDir(filepath.FromSlash("foo/bar")))
+	assert.False(t, p.SkipsDir("."), "the directories of the arguments are not skipped")
+}
@@ -13,47 +13,61 @@ var _ Processor = (*SkipFiles)(nil)
 type SkipFiles struct {
 	patterns   []*regexp.Regexp
 	pathPrefix string
+	exclusionsCounter
 }
 
 func NewSkipFiles(patterns []string, pathPrefix string) (*SkipFiles, error) {
-	var patternsRe []*regexp.Regexp
-	for _, p := range patterns {
-		p = fsutils.NormalizePathInRegex(p)
+	p := &SkipFiles{pathPrefix: pathPrefix}
 
-		patternRe, err := regexp.Compile(p)
+	for i, pattern := range patterns {
+		normalized := fsutils.NormalizePathInRegex(pattern)
+
+		patternRe, err := regexp.Compile(normalized)
 		if err != nil {
-			return nil, fmt.Errorf("can't compile regexp %q: %w", p, err)
+			return nil, fmt.Errorf("can't compile regexp %q: %w", normalized, err)
 		}
 
-		patternsRe = append(patternsRe, patternRe)
+		p.patterns = append(p.patterns, patternRe)
+		p.add("exclude-files pattern", "issues.exclude-files", i, pattern)
 	}
 
-	return &SkipFiles{
-		patterns:   patternsRe,
-		pathPrefix: pathPrefix,
-	}, nil
+	return p, nil
 }
 
-func (SkipFiles) Name() string {
+func (*SkipFiles) Name() string {
 	return "skip_files"
 }
 
-func (p SkipFiles) Process(issues []result.Issue) ([]result.Issue, error) {
+func (p *SkipFiles) Process(issues []result.Issue) ([]result.Issue, error) {
 	if len(p.patterns) == 0 {
 		return issues, nil
 	}
 
 	return filterIssues(issues, func(issue *result.Issue) bool {
-		path := fsutils.WithPathPrefix(p.pathPrefix, issue.FilePath())
-
-		for _, pattern := range p.patterns {
-			if pattern.MatchString(path) {
-				return false
-			}
-		}
+		i := p.matchFile(issue.FilePath())
+		p.match(i)
 
-		return true
+		return i < 0
 	}), nil
 }
 
-func (SkipFiles) Finish() {}
+// SkipsFile reports whether the issues of the file (relative to the working directory) are skipped.
+// It is also used to exclude the files from the watched paths.
+func (p *SkipFiles) SkipsFile(relPath string) bool {
+	return p.matchFile(relPath) >= 0
+}
+
+func (*SkipFiles) Finish() {}
+
+// matchFile returns the index of the pattern matching the file, or -1.
+func (p *SkipFiles) matchFile(relPath string) int {
+	path := fsutils.WithPathPrefix(p.pathPrefix, relPath)
+
+	for i, pattern := range p.patterns {
+		if pattern.MatchString(path) {
+			return i
+		}
+	}
+
+	return -1
+}
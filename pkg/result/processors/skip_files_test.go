@@ -52,3 +52,10 @@ func TestSkipFilesInvalidPattern(t *testing.T) {
 	require.Error(t, err)
 	assert.Nil(t, p)
 }
+
+func TestSkipFiles_SkipsFile(t *testing.T) {
+	p := newTestSkipFiles(t, ".*\\.pb\\.go$")
+
+	assert.True(t, p.SkipsFile(filepath.FromSlash("a/b.pb.go")))
+	assert.False(t, p.SkipsFile(filepath.FromSlash("a/b.go")))
+}
@@ -0,0 +1,47 @@
+package processors
+
+import (
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+var _ Processor = (*SkipSuppressed)(nil)
+
+// SkipSuppressed runs a processor only on the issues which are not suppressed.
+// The suppressed issues are kept with their suppression for the output (`output.print-suppressed`):
+// they must not be counted by the limits, fixed, or added to the baseline, but they still go through the other processors.
+type SkipSuppressed struct {
+	Processor
+}
+
+func NewSkipSuppressed(p Processor) *SkipSuppressed {
+	return &SkipSuppressed{Processor: p}
+}
+
+func (p *SkipSuppressed) Process(issues []result.Issue) ([]result.Issue, error) {
+	active, suppressed := SplitSuppressed(issues)
+	if len(suppressed) == 0 {
+		return p.Processor.Process(issues)
+	}
+
+	processed, err := p.Processor.Process(active)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(processed, suppressed...), nil
+}
+
+// SplitSuppressed separates the issues which are not suppressed from the suppressed issues.
+func SplitSuppressed(issues []result.Issue) (active, suppressed []result.Issue) {
+	active = make([]result.Issue, 0, len(issues))
+
+	for i := range issues {
+		if issues[i].Suppression != nil {
+			suppressed = append(suppressed, issues[i])
+		} else {
+			active = append(active, issues[i])
+		}
+	}
+
+	return active, suppressed
+}
@@ -0,0 +1,37 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+func TestSkipSuppressed(t *testing.T) {
+	p := NewSkipSuppressed(NewMaxFromLinter(1, logutils.NewStderrLog(logutils.DebugKeyEmpty), &config.Config{}))
+
+	suppressed := newFromLinterIssue("gosimple")
+	suppressed.Suppression = &result.Suppression{Justification: "false positive"}
+
+	gosimple := newFromLinterIssue("gosimple")
+
+	// The suppressed issue is kept, and isn't counted by the limit.
+	processAssertSame(t, p, gosimple, suppressed)
+	processAssertEmpty(t, p, gosimple)
+	processAssertSame(t, p, suppressed)
+
+	assert.Equal(t, "max_from_linter", p.Name())
+}
+
+func TestSplitSuppressed(t *testing.T) {
+	suppressed := newFromLinterIssue("gofmt")
+	suppressed.Suppression = &result.Suppression{}
+
+	active, split := SplitSuppressed([]result.Issue{newFromLinterIssue("gosimple"), suppressed, newFromLinterIssue("govet")})
+
+	assert.Equal(t, []result.Issue{newFromLinterIssue("gosimple"), newFromLinterIssue("govet")}, active)
+	assert.Equal(t, []result.Issue{suppressed}, split)
+}
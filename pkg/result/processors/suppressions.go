@@ -0,0 +1,287 @@
+package processors
+
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/maps"
+	"gopkg.in/yaml.v3"
+
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/lint/lintersdb"
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+	"github.com/snowmerak/golangci-lint/pkg/timeutils"
+)
+
+// SuppressionsLinter is the name of the pseudo-linter reporting the expired entries of the suppressions file.
+const SuppressionsLinter = "suppressions"
+
+const suppressionDateLayout = time.DateOnly
+
+var _ Processor = (*Suppressions)(nil)
+
+type suppressionsFile struct {
+	Suppressions []yaml.Node `yaml:"suppressions"`
+}
+
+type suppressionEntry struct {
+	Path    string `yaml:"path"`
+	Symbol  string `yaml:"symbol"`
+	Linter  string `yaml:"linter"`
+	RuleID  string `yaml:"rule-id"`
+	Reason  string `yaml:"reason"`
+	Expires string `yaml:"expires"`
+
+	// The line of the entry inside the suppressions file.
+	line int
+	// The linter names matched by the entry: the aliases are resolved.
+	linters []string
+	expired bool
+}
+
+func (e *suppressionEntry) match(issue *result.Issue) bool {
+	path := filepath.ToSlash(issue.FilePath())
+	if path != e.Path && !strings.HasPrefix(path, e.Path+"/") {
+		return false
+	}
+
+	if e.RuleID != "" && e.RuleID != issue.RuleID {
+		return false
+	}
+
+	for _, linter := range e.linters {
+		if linter == issue.FromLinter {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Suppressions hides the issues matching the entries of a suppressions file (`issues.suppressions`):
+// an alternative to the nolint directives keeping the source code free of lint comments.
+// An entry matches the issues of a linter inside a file or a directory,
+// optionally inside a declaration (symbol) and for a rule ID.
+// The expired entries don't hide issues, they are reported.
+type Suppressions struct {
+	log logutils.Log
+
+	filename string
+	entries  []suppressionEntry
+
+	// The top-level declarations of the files, to resolve the symbols.
+	declarations map[string][]declaration
+
+	unknownLintersSet map[string]bool
+
+	keepSuppressed bool
+}
+
+func NewSuppressions(log logutils.Log, dbManager *lintersdb.Manager, cfg *config.Config) (*Suppressions, error) {
+	return newSuppressions(log, dbManager, cfg, time.Now())
+}
+
+func newSuppressions(log logutils.Log, dbManager *lintersdb.Manager, cfg *config.Config, now time.Time) (*Suppressions, error) {
+	p := &Suppressions{
+		log:               log,
+		filename:          cfg.Issues.Suppressions,
+		declarations:      map[string][]declaration{},
+		unknownLintersSet: map[string]bool{},
+		keepSuppressed:    cfg.Output.PrintSuppressed,
+	}
+
+	if p.filename == "" {
+		return p, nil
+	}
+
+	entries, err := readSuppressionsFile(p.filename)
+	if err != nil {
+		return nil, err
+	}
+
+	today := now.Format(suppressionDateLayout)
+
+	for i := range entries {
+		entry := &entries[i]
+
+		entry.expired = entry.Expires != "" && timeutils.Expired(entry.Expires, today)
+
+		lcs := dbManager.GetLinterConfigs(entry.Linter)
+		if lcs == nil {
+			p.unknownLintersSet[entry.Linter] = true
+			entry.linters = []string{entry.Linter}
+
+			continue
+		}
+
+		for _, lc := range lcs {
+			entry.linters = append(entry.linters, lc.Name()) // normalize name to work with aliases
+		}
+	}
+
+	p.entries = entries
+
+	return p, nil
+}
+
+func (*Suppressions) Name() string {
+	return "suppressions"
+}
+
+func (p *Suppressions) Process(issues []result.Issue) ([]result.Issue, error) {
+	if len(p.entries) == 0 {
+		return issues, nil
+	}
+
+	return filterIssues(issues, p.shouldPassIssue), nil
+}
+
+func (p *Suppressions) Finish() {
+	if len(p.unknownLintersSet) == 0 {
+		return
+	}
+
+	unknownLinters := maps.Keys(p.unknownLintersSet)
+	sort.Strings(unknownLinters)
+
+	p.log.Warnf("Found unknown linters in the suppressions file %s: %s", p.filename, strings.Join(unknownLinters, ", "))
+}
+
+// Issues returns the issues of the expired entries.
+// Their positions are relative to the current directory, like the positions of the issues before the output processing.
+func (p *Suppressions) Issues() []result.Issue {
+	var issues []result.Issue
+
+	for i := range p.entries {
+		entry := &p.entries[i]
+		if !entry.expired {
+			continue
+		}
+
+		target := entry.Path
+		if entry.Symbol != "" {
+			target += " (" + entry.Symbol + ")"
+		}
+
+		issues = append(issues, result.Issue{
+			FromLinter: SuppressionsLinter,
+			Text: fmt.Sprintf("suppression of %s for %s expired on %s: %s",
+				entry.Linter, target, entry.Expires, entry.Reason),
+			Pos: token.Position{
+				Filename: p.filename,
+				Line:     entry.line,
+			},
+		})
+	}
+
+	return issues
+}
+
+func (p *Suppressions) shouldPassIssue(issue *result.Issue) bool {
+	for i := range p.entries {
+		entry := &p.entries[i]
+
+		if entry.expired || !entry.match(issue) {
+			continue
+		}
+
+		if entry.Symbol != "" && !p.matchSymbol(entry.Symbol, issue) {
+			continue
+		}
+
+		if p.keepSuppressed {
+			issue.Suppression = &result.Suppression{Kind: result.SuppressionExternal, Justification: entry.Reason}
+			return true
+		}
+
+		return false
+	}
+
+	return true
+}
+
+// matchSymbol reports whether the issue is inside the top-level declaration named by the symbol:
+// a function, a type (with its methods), or a method (`Type.Method`).
+func (p *Suppressions) matchSymbol(symbol string, issue *result.Issue) bool {
+	declarations, ok := p.declarations[issue.FilePath()]
+	if !ok {
+		declarations = parseDeclarations(issue.FilePath())
+		p.declarations[issue.FilePath()] = declarations
+	}
+
+	for _, decl := range declarations {
+		if decl.name != symbol && !strings.HasPrefix(decl.name, symbol+".") {
+			continue
+		}
+
+		if decl.contains(issue) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func readSuppressionsFile(filename string) ([]suppressionEntry, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read suppressions: %w", err)
+	}
+
+	var file suppressionsFile
+	if err = yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse suppressions %s: %w", filename, err)
+	}
+
+	entries := make([]suppressionEntry, 0, len(file.Suppressions))
+
+	for i := range file.Suppressions {
+		node := &file.Suppressions[i]
+
+		var entry suppressionEntry
+		if err = node.Decode(&entry); err != nil {
+			return nil, fmt.Errorf("failed to parse suppressions %s: %w", filename, err)
+		}
+
+		entry.line = node.Line
+		entry.Path = strings.TrimSuffix(filepath.ToSlash(filepath.Clean(entry.Path)), "/")
+		entry.Linter = strings.ToLower(strings.TrimSpace(entry.Linter))
+
+		if err = entry.validate(); err != nil {
+			return nil, fmt.Errorf("invalid suppression at %s:%d: %w", filename, entry.line, err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (e *suppressionEntry) validate() error {
+	if e.Path == "." || e.Path == "" {
+		return errors.New("path is required")
+	}
+
+	if e.Linter == "" {
+		return errors.New("linter is required")
+	}
+
+	if strings.TrimSpace(e.Reason) == "" {
+		return errors.New("reason is required")
+	}
+
+	if e.Expires != "" {
+		if _, err := time.Parse(suppressionDateLayout, e.Expires); err != nil {
+			return fmt.Errorf("invalid expiry date %q: expected YYYY-MM-DD", e.Expires)
+		}
+	}
+
+	return nil
+}
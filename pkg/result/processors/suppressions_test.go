@@ -0,0 +1,128 @@
+package processors
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/lint/lintersdb"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+func newTestSuppressions(t *testing.T, filename string, keepSuppressed bool) (*Suppressions, error) {
+	t.Helper()
+
+	log := getMockLog()
+
+	dbManager, err := lintersdb.NewManager(log, config.NewDefault(), lintersdb.NewLinterBuilder())
+	require.NoError(t, err)
+
+	cfg := &config.Config{
+		Issues: config.Issues{Suppressions: filename},
+		Output: config.Output{PrintSuppressed: keepSuppressed},
+	}
+
+	return newSuppressions(log, dbManager, cfg, time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC))
+}
+
+func TestSuppressions(t *testing.T) {
+	p, err := newTestSuppressions(t, filepath.Join("testdata", "suppressions.yml"), true)
+	require.NoError(t, err)
+
+	fingerprintIssue := func(line int, linter, ruleID string) result.Issue {
+		issue := newIssueFromIssueTestCase(issueTestCase{Path: filepath.Join("testdata", "fingerprint.go"), Line: line, Linter: linter})
+		issue.RuleID = ruleID
+
+		return issue
+	}
+
+	// suppressed: the issues are kept with their suppression
+	suppressed := process(t, p,
+		fingerprintIssue(4, "errcheck", ""),
+		fingerprintIssue(8, "errcheck", ""),
+		fingerprintIssue(13, "gosec", "G104"),
+		fingerprintIssue(3, "revive", ""),
+	)
+	require.Len(t, suppressed, 4)
+
+	for i := range suppressed {
+		require.NotNil(t, suppressed[i].Suppression)
+		assert.Equal(t, result.SuppressionExternal, suppressed[i].Suppression.Kind)
+	}
+
+	assert.Equal(t, "the errors of Foo are checked by the caller.", suppressed[0].Suppression.Justification)
+	assert.Equal(t, "the errors are checked by errcheck.", suppressed[2].Suppression.Justification)
+
+	// not suppressed: another symbol, another rule ID, another linter, expired entry, another file
+	processAssertSame(t, p,
+		fingerprintIssue(13, "errcheck", ""),
+		fingerprintIssue(13, "gosec", "G101"),
+		fingerprintIssue(8, "govet", ""),
+		fingerprintIssue(17, "unused", ""),
+		newIssueFromIssueTestCase(issueTestCase{Path: "fingerprint.go", Line: 8, Linter: "errcheck"}),
+	)
+
+	expected := []result.Issue{{
+		FromLinter: SuppressionsLinter,
+		Text:       "suppression of unused for testdata/fingerprint.go (Func) expired on 2020-01-31: Func is used by the tests.",
+		Pos:        token.Position{Filename: filepath.Join("testdata", "suppressions.yml"), Line: 10},
+	}}
+
+	assert.Equal(t, expected, p.Issues())
+}
+
+func TestSuppressions_noFile(t *testing.T) {
+	p, err := newTestSuppressions(t, "", false)
+	require.NoError(t, err)
+
+	processAssertSame(t, p, newIssueFromTextTestCase("test"))
+	assert.Empty(t, p.Issues())
+}
+
+func TestSuppressions_invalid(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		content  string
+		expected string
+	}{
+		{
+			desc:     "no path",
+			content:  "suppressions:\n  - linter: errcheck\n    reason: test\n",
+			expected: "path is required",
+		},
+		{
+			desc:     "no linter",
+			content:  "suppressions:\n  - path: a.go\n    reason: test\n",
+			expected: "linter is required",
+		},
+		{
+			desc:     "no reason",
+			content:  "suppressions:\n  - path: a.go\n    linter: errcheck\n",
+			expected: "reason is required",
+		},
+		{
+			desc:     "invalid date",
+			content:  "suppressions:\n  - path: a.go\n    linter: errcheck\n    reason: test\n    expires: 31/01/2020\n",
+			expected: `invalid expiry date "31/01/2020": expected YYYY-MM-DD`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			filename := filepath.Join(t.TempDir(), "suppressions.yml")
+			require.NoError(t, os.WriteFile(filename, []byte(test.content), 0o600))
+
+			_, err := newTestSuppressions(t, filename, false)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.expected)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package testdata
+
+type Foo struct {
+	Bar string
+}
+
+func (f *Foo) Method() {
+	_ = f.Bar
+	_ = f.Bar
+}
+
+var (
+	a = 1
+	b = 2
+)
+
+func Func() {}
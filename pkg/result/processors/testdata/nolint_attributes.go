@@ -0,0 +1,11 @@
+package testdata
+
+func RetError() error {
+	return nil
+}
+
+func MissedErrorCheck() {
+	RetError() //nolint:errcheck until=2999-12-31 issue=PROJ-123 // will be fixed
+	RetError() //nolint until=2999-12-31
+	RetError() //nolint:gosec issue=PROJ-123
+}
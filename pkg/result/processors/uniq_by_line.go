@@ -42,6 +42,11 @@ func (p *UniqByLine) shouldPassIssue(issue *result.Issue) bool {
 		return true
 	}
 
+	if p.cfg.Issues.AddNolint != "" {
+		// the nolint directive of the line must contain the linters of all the issues
+		return true
+	}
+
 	if p.fileLineCounter.GetCount(issue) == uniqByLineLimit {
 		return false
 	}
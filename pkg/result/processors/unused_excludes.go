@@ -0,0 +1,233 @@
+package processors
+
+import (
+	"fmt"
+	"go/token"
+	"slices"
+	"strings"
+
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+// UnusedExcludesLinter is the name of the pseudo-linter reporting the exclusions which matched no issue.
+const UnusedExcludesLinter = "unusedexcludes"
+
+var _ Processor = (*UnusedExcludes)(nil)
+
+// Exclusion is an exclusion of the configuration (a pattern or a rule) with the number of issues it matched.
+type Exclusion struct {
+	// The kind of exclusion (ex: `exclude rule`).
+	Kind string
+	// The configuration key of the list containing the exclusion (ex: `issues.exclude-rules`).
+	Key string
+	// The index of the exclusion inside the list, or -1 for a default exclusion.
+	Index int
+	// The description of the exclusion: its pattern, its conditions, or its id.
+	Description string
+
+	// The position of the exclusion inside the configuration file, set when the run is finished.
+	Pos token.Position
+
+	Matches int
+}
+
+func (e *Exclusion) text() string {
+	if e.Index < 0 {
+		return fmt.Sprintf("%s %s matched no issue: it can be disabled with `%s`", e.Kind, e.Description, e.Key)
+	}
+
+	return fmt.Sprintf("%s `%s[%d]` matched no issue: %s", e.Kind, e.Key, e.Index, e.Description)
+}
+
+// exclusionsReporter is implemented by the processors excluding or matching issues with the exclusions of the configuration.
+type exclusionsReporter interface {
+	Exclusions() []Exclusion
+}
+
+// exclusionsCounter counts the issues matched by each exclusion of a processor.
+type exclusionsCounter struct {
+	exclusions []Exclusion
+}
+
+func (c *exclusionsCounter) add(kind, key string, index int, description string) {
+	c.exclusions = append(c.exclusions, Exclusion{Kind: kind, Key: key, Index: index, Description: description})
+}
+
+func (c *exclusionsCounter) match(i int) {
+	if i >= 0 && i < len(c.exclusions) {
+		c.exclusions[i].Matches++
+	}
+}
+
+// Exclusions returns the exclusions with the numbers of issues they matched.
+func (c exclusionsCounter) Exclusions() []Exclusion {
+	return slices.Clone(c.exclusions)
+}
+
+// UnusedExcludes collects the exclusions of the processors when the run is finished,
+// to report the ones which matched no issue (`issues.report-unused-excludes`).
+type UnusedExcludes struct {
+	cfg *config.Config
+
+	reporters  []exclusionsReporter
+	exclusions []Exclusion
+}
+
+// NewUnusedExcludes creates the processor collecting the exclusions of the processors.
+// It must be finished after the processors.
+func NewUnusedExcludes(cfg *config.Config, processors ...Processor) *UnusedExcludes {
+	p := &UnusedExcludes{cfg: cfg}
+
+	for _, processor := range processors {
+		if reporter, ok := processor.(exclusionsReporter); ok {
+			p.reporters = append(p.reporters, reporter)
+		}
+	}
+
+	return p
+}
+
+func (*UnusedExcludes) Name() string {
+	return "unused_excludes"
+}
+
+func (*UnusedExcludes) Process(issues []result.Issue) ([]result.Issue, error) {
+	return issues, nil
+}
+
+func (p *UnusedExcludes) Finish() {
+	p.exclusions = nil
+
+	for _, reporter := range p.reporters {
+		for _, exclusion := range reporter.Exclusions() {
+			exclusion.Pos = p.position(exclusion.Key)
+			p.exclusions = append(p.exclusions, exclusion)
+		}
+	}
+}
+
+// Exclusions returns the exclusions of the configuration with the numbers of issues they matched.
+func (p *UnusedExcludes) Exclusions() []Exclusion {
+	return p.exclusions
+}
+
+// Issues returns the issues of the exclusions which matched no issue,
+// or nil if `issues.report-unused-excludes` is disabled.
+func (p *UnusedExcludes) Issues() []result.Issue {
+	if !p.cfg.Issues.ReportUnusedExcludes {
+		return nil
+	}
+
+	return unusedExclusionsIssues(p.exclusions)
+}
+
+// position returns the position of the list of an exclusion:
+// the position of its value inside a configuration file, else the configuration file.
+func (p *UnusedExcludes) position(key string) token.Position {
+	var pos token.Position
+
+	if source := p.cfg.GetSource(key); source.Kind == config.SourceFile {
+		pos = token.Position{Filename: source.Name, Line: source.Line}
+	} else {
+		pos = token.Position{Filename: p.cfg.GetConfigFile()}
+	}
+
+	return pos
+}
+
+// MergeUnusedExcludes keeps, among the unused exclusions reported by several analyses,
+// the exclusions which matched no issue in all the analyses, once.
+// The reported issues are kept as they are processed (ex: with their severity).
+func MergeUnusedExcludes(issues []result.Issue, exclusions []Exclusion) []result.Issue {
+	type exclusionKey struct {
+		text string
+		pos  token.Position
+	}
+
+	unused := map[exclusionKey]bool{}
+
+	for _, exclusion := range mergeExclusions(exclusions) {
+		if exclusion.Matches == 0 {
+			unused[exclusionKey{text: exclusion.text(), pos: exclusion.Pos}] = true
+		}
+	}
+
+	merged := make([]result.Issue, 0, len(issues))
+
+	for i := range issues {
+		if issues[i].FromLinter == UnusedExcludesLinter {
+			key := exclusionKey{text: issues[i].Text, pos: issues[i].Pos}
+			if !unused[key] {
+				continue
+			}
+
+			// The same exclusion is reported by each analysis.
+			delete(unused, key)
+		}
+
+		merged = append(merged, issues[i])
+	}
+
+	return merged
+}
+
+// mergeExclusions adds the numbers of matched issues of the same exclusions.
+func mergeExclusions(exclusions []Exclusion) []Exclusion {
+	index := map[Exclusion]int{}
+
+	var merged []Exclusion
+
+	for _, exclusion := range exclusions {
+		key := exclusion
+		key.Matches = 0
+
+		if i, ok := index[key]; ok {
+			merged[i].Matches += exclusion.Matches
+			continue
+		}
+
+		index[key] = len(merged)
+		merged = append(merged, exclusion)
+	}
+
+	return merged
+}
+
+func unusedExclusionsIssues(exclusions []Exclusion) []result.Issue {
+	var issues []result.Issue
+
+	for i := range exclusions {
+		if exclusions[i].Matches > 0 {
+			continue
+		}
+
+		issues = append(issues, result.Issue{
+			FromLinter: UnusedExcludesLinter,
+			Text:       exclusions[i].text(),
+			Pos:        exclusions[i].Pos,
+		})
+	}
+
+	return issues
+}
+
+// describeRule returns the conditions of a rule (ex: `path: _test\.go; linters: errcheck`).
+func describeRule(rule *config.BaseRule) string {
+	var parts []string
+
+	add := func(name, value string) {
+		if value != "" {
+			parts = append(parts, name+": "+value)
+		}
+	}
+
+	add("path", rule.Path)
+	add("path-except", rule.PathExcept)
+	add("linters", strings.Join(rule.Linters, ", "))
+	add("rule-ids", strings.Join(rule.RuleIDs, ", "))
+	add("text", rule.Text)
+	add("source", rule.Source)
+
+	return strings.Join(parts, "; ")
+}
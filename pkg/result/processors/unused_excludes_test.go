@@ -0,0 +1,141 @@
+package processors
+
+import (
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/snowmerak/golangci-lint/pkg/config"
+	"github.com/snowmerak/golangci-lint/pkg/fsutils"
+	"github.com/snowmerak/golangci-lint/pkg/logutils"
+	"github.com/snowmerak/golangci-lint/pkg/result"
+)
+
+func TestUnusedExcludes(t *testing.T) {
+	lineCache := fsutils.NewLineCache(fsutils.NewFileCache())
+	files := fsutils.NewFiles(lineCache, "")
+
+	cfg := &config.Config{
+		Issues: config.Issues{
+			ExcludePatterns: []string{"^used$", "^unused$"},
+			ExcludeRules: []config.ExcludeRule{
+				{BaseRule: config.BaseRule{Linters: []string{"linter"}, Path: `_test\.go`}},
+				{BaseRule: config.BaseRule{Text: "^never$", Linters: []string{"linter"}}},
+			},
+			UseDefaultExcludes: true,
+			IncludeDefaultExcludes: []string{
+				"EXC0001", "EXC0002", "EXC0003", "EXC0004", "EXC0005", "EXC0006", "EXC0007",
+				"EXC0008", "EXC0009", "EXC0010", "EXC0011", "EXC0012", "EXC0013",
+			},
+			ExcludeFiles:         []string{`\.pb\.go$`},
+			ExcludeDirs:          []string{"generated"},
+			ReportUnusedExcludes: true,
+		},
+		Severity: config.Severity{
+			Rules: []config.SeverityRule{
+				{Severity: "info", BaseRule: config.BaseRule{Linters: []string{"linter"}}},
+			},
+		},
+	}
+
+	skipFiles, err := NewSkipFiles(cfg.Issues.ExcludeFiles, "")
+	require.NoError(t, err)
+
+	skipDirs, err := NewSkipDirs(logutils.NewStderrLog(logutils.DebugKeyEmpty),
+		append(cfg.Issues.ExcludeDirs, StdExcludeDirRegexps...), []string{"./..."}, "")
+	require.NoError(t, err)
+
+	processors := []Processor{
+		skipFiles,
+		skipDirs,
+		NewExclude(&cfg.Issues),
+		NewExcludeRules(nil, files, &cfg.Issues),
+		NewSeverity(nil, files, &cfg.Severity),
+	}
+
+	p := NewUnusedExcludes(cfg, processors...)
+
+	issues := []result.Issue{
+		newIssueFromIssueTestCase(issueTestCase{Path: "e.go", Text: "used", Linter: "linter"}),
+		newIssueFromIssueTestCase(issueTestCase{Path: "e_test.go", Text: "some", Linter: "linter"}),
+		newIssueFromIssueTestCase(issueTestCase{Path: "e.go", Text: "should have a package comment", Linter: "revive"}),
+		newIssueFromIssueTestCase(issueTestCase{Path: "vendor/e.go", Text: "some", Linter: "linter"}),
+		newIssueFromIssueTestCase(issueTestCase{Path: "e.go", Text: "other", Linter: "linter"}),
+	}
+
+	for _, processor := range append(processors, p) {
+		issues = process(t, processor, issues...)
+	}
+
+	for _, processor := range append(processors, p) {
+		processor.Finish()
+	}
+
+	require.Len(t, issues, 1)
+	assert.Equal(t, "info", issues[0].Severity)
+
+	var texts []string
+	for _, issue := range p.Issues() {
+		assert.Equal(t, UnusedExcludesLinter, issue.FromLinter)
+		texts = append(texts, issue.Text)
+	}
+
+	expected := []string{
+		"exclude-files pattern `issues.exclude-files[0]` matched no issue: \\.pb\\.go$",
+		"exclude-dirs pattern `issues.exclude-dirs[0]` matched no issue: generated",
+		"exclude pattern `issues.exclude[1]` matched no issue: ^unused$",
+		"exclude rule `issues.exclude-rules[1]` matched no issue: linters: linter; text: ^never$",
+		"default exclusion EXC0014 matched no issue: it can be disabled with `issues.include`",
+	}
+
+	assert.Equal(t, expected, texts)
+}
+
+func TestUnusedExcludes_disabled(t *testing.T) {
+	cfg := &config.Config{Issues: config.Issues{ExcludePatterns: []string{"^unused$"}}}
+
+	p := NewUnusedExcludes(cfg, NewExclude(&cfg.Issues))
+	p.Finish()
+
+	assert.Empty(t, p.Issues())
+	assert.Len(t, p.Exclusions(), 1)
+}
+
+func TestMergeUnusedExcludes(t *testing.T) {
+	pos := token.Position{Filename: ".golangci.yml", Line: 3}
+
+	exclusions := []Exclusion{
+		{Kind: "exclude pattern", Key: "issues.exclude", Index: 0, Description: "^a$", Pos: pos, Matches: 0},
+		{Kind: "exclude pattern", Key: "issues.exclude", Index: 1, Description: "^b$", Pos: pos, Matches: 0},
+		{Kind: "exclude pattern", Key: "issues.exclude", Index: 0, Description: "^a$", Pos: pos, Matches: 2},
+		{Kind: "exclude pattern", Key: "issues.exclude", Index: 1, Description: "^b$", Pos: pos, Matches: 0},
+	}
+
+	reported := unusedExclusionsIssues(exclusions)
+	for i := range reported {
+		reported[i].Severity = "warning"
+	}
+
+	issues := []result.Issue{
+		{FromLinter: "linter", Text: "issue"},
+		reported[0],
+		reported[1],
+		reported[2],
+	}
+
+	merged := MergeUnusedExcludes(issues, exclusions)
+
+	expected := []result.Issue{
+		{FromLinter: "linter", Text: "issue"},
+		{
+			FromLinter: UnusedExcludesLinter,
+			Text:       "exclude pattern `issues.exclude[1]` matched no issue: ^b$",
+			Severity:   "warning",
+			Pos:        pos,
+		},
+	}
+
+	assert.Equal(t, expected, merged)
+}
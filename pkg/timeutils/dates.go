@@ -0,0 +1,7 @@
+package timeutils
+
+// Expired reports whether a date is before today: the dates have the layout time.DateOnly.
+func Expired(date, today string) bool {
+	// The dates have the same layout: the lexical order is the chronological order.
+	return date < today
+}